@@ -3,6 +3,7 @@ package grpcgateway
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"strconv"
 
@@ -10,10 +11,18 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-const cookieName = "Authorization"
+const (
+	cookieName = "Authorization"
+
+	headerRealIP  = "x-real-ip"
+	headerReferer = "referer"
+)
 
 func metadataAnnotator(_ context.Context, r *http.Request) metadata.MD {
-	md := make(map[string]string)
+	md := map[string]string{
+		headerRealIP:  remoteIP(r.RemoteAddr),
+		headerReferer: r.Referer(),
+	}
 
 	cookie, err := r.Cookie(cookieName)
 	if err != nil {
@@ -25,6 +34,16 @@ func metadataAnnotator(_ context.Context, r *http.Request) metadata.MD {
 	return metadata.New(md)
 }
 
+// remoteIP returns client IP set by RealIP middleware without port.
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+
+	return host
+}
+
 func httpResponseModifier(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
 	headers := w.Header()
 
@@ -5,17 +5,26 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/vstdy/go-shortener/api/grpc/model"
 	"github.com/vstdy/go-shortener/pkg"
 	"github.com/vstdy/go-shortener/pkg/grpc/url-service"
+	"github.com/vstdy/go-shortener/pkg/logging"
+)
+
+const (
+	// errorDomain is the ErrorInfo domain of the service errors.
+	errorDomain = "shortener"
 )
 
 // ShortenURL creates shortcut for given url.
@@ -35,7 +44,11 @@ func (srv *gRPCServer) ShortenURL(
 	err := srv.service.AddURL(ctx, &obj)
 	if err != nil {
 		if errors.Is(err, pkg.ErrInvalidInput) {
-			return nil, status.Error(codes.InvalidArgument, pkg.ErrInvalidInput.Error())
+			return nil, invalidArgumentError(err, pkg.ErrInvalidInput.Error())
+		}
+
+		if errors.Is(err, pkg.ErrCodeTaken) {
+			return nil, status.Error(codes.AlreadyExists, pkg.ErrCodeTaken.Error())
 		}
 
 		if !errors.Is(err, pkg.ErrAlreadyExists) {
@@ -70,7 +83,7 @@ func (srv *gRPCServer) ShortenURLsBatch(
 	err := srv.service.AddURLsBatch(ctx, &objs)
 	if err != nil {
 		if errors.Is(err, pkg.ErrInvalidInput) {
-			return nil, status.Error(codes.InvalidArgument, pkg.ErrInvalidInput.Error())
+			return nil, invalidArgumentError(err, pkg.ErrInvalidInput.Error())
 		}
 
 		if !errors.Is(err, pkg.ErrAlreadyExists) {
@@ -95,12 +108,7 @@ func (srv *gRPCServer) GetOriginalURL(
 
 	header := metadata.New(map[string]string{})
 
-	urlID, err := strconv.Atoi(in.GetId())
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, pkg.ErrInvalidInput.Error())
-	}
-
-	url, err := srv.service.GetURL(ctx, urlID)
+	obj, err := srv.service.GetURL(ctx, in.GetId())
 	if err != nil {
 		if errors.Is(err, pkg.ErrInvalidInput) {
 			return nil, status.Error(codes.InvalidArgument, pkg.ErrInvalidInput.Error())
@@ -109,14 +117,24 @@ func (srv *gRPCServer) GetOriginalURL(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	if url == "" {
+	if obj.URL == "" {
 		header.Set("x-http-code", strconv.Itoa(http.StatusGone))
 		grpc.SetHeader(ctx, header)
 		return &emptypb.Empty{}, nil
 	}
 
-	header.Set("location", url)
-	header.Set("x-http-code", strconv.Itoa(http.StatusTemporaryRedirect))
+	md, _ := metadata.FromIncomingContext(ctx)
+	var peerAddr string
+	if p, ok := peer.FromContext(ctx); ok {
+		peerAddr = p.Addr.String()
+	}
+	if err = srv.service.AddClick(ctx, model.NewClickFromMD(obj.ID, md, peerAddr)); err != nil {
+		_, logger := logging.GetCtxLogger(ctx)
+		logger.Warn().Err(err).Msg("Recording click:")
+	}
+
+	header.Set("location", obj.URL)
+	header.Set("x-http-code", strconv.Itoa(obj.RedirectCode))
 	grpc.SetHeader(ctx, header)
 
 	return &emptypb.Empty{}, nil
@@ -124,7 +142,7 @@ func (srv *gRPCServer) GetOriginalURL(
 
 // GetUsersURLs returns urls created by current user.
 func (srv *gRPCServer) GetUsersURLs(
-	ctx context.Context, _ *emptypb.Empty) (
+	ctx context.Context, in *urlService.GetUsersURLsReq) (
 	*urlService.GetUsersURLsResp, error) {
 
 	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
@@ -132,12 +150,127 @@ func (srv *gRPCServer) GetUsersURLs(
 		return nil, status.Error(codes.Internal, "context: failed to retrieve user_id")
 	}
 
-	urls, err := srv.service.GetUsersURLs(ctx, userID)
+	page, err := srv.service.GetUsersURLs(ctx, userID, model.GetUsersURLsReqToCanon(in))
 	if err != nil {
+		if errors.Is(err, pkg.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	out := model.GetUsersURLsRespFromCanon(urls, srv.config.BaseURL)
+	out := model.GetUsersURLsRespFromCanon(page, srv.config.BaseURL)
+
+	return out, nil
+}
+
+// GetURLStats returns clicks statistics of url created by current user.
+func (srv *gRPCServer) GetURLStats(
+	ctx context.Context, in *urlService.GetURLStatsReq) (
+	*urlService.GetURLStatsResp, error) {
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		return nil, status.Error(codes.Internal, "context: failed to retrieve user_id")
+	}
+
+	stats, err := srv.service.GetURLStats(ctx, userID, in.GetId(), model.GetURLStatsReqToCanon(in))
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, pkg.ErrNotFound):
+			return nil, status.Error(codes.NotFound, pkg.ErrNotFound.Error())
+		case errors.Is(err, pkg.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, pkg.ErrForbidden.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := model.GetURLStatsRespFromCanon(stats)
+
+	return out, nil
+}
+
+// UpdateURL updates url created by current user.
+func (srv *gRPCServer) UpdateURL(
+	ctx context.Context, in *urlService.UpdateURLReq) (
+	*urlService.UpdateURLResp, error) {
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		return nil, status.Error(codes.Internal, "context: failed to retrieve user_id")
+	}
+
+	obj := model.UpdateURLReqToCanon(in, userID)
+
+	err := srv.service.UpdateURL(ctx, &obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrInvalidInput):
+			return nil, invalidArgumentError(err, err.Error())
+		case errors.Is(err, pkg.ErrNotFound):
+			return nil, status.Error(codes.NotFound, pkg.ErrNotFound.Error())
+		case errors.Is(err, pkg.ErrAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, pkg.ErrAlreadyExists.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := model.UpdateURLRespFromCanon(obj, srv.config.BaseURL)
+
+	return out, nil
+}
+
+// GetUsersDeletedURLs returns urls deleted by current user within restore grace period.
+func (srv *gRPCServer) GetUsersDeletedURLs(
+	ctx context.Context, _ *emptypb.Empty) (
+	*urlService.GetUsersDeletedURLsResp, error) {
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		return nil, status.Error(codes.Internal, "context: failed to retrieve user_id")
+	}
+
+	urls, err := srv.service.GetUsersDeletedURLs(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := model.GetUsersDeletedURLsRespFromCanon(urls, srv.config.BaseURL)
+
+	return out, nil
+}
+
+// RestoreURL restores url deleted by current user.
+func (srv *gRPCServer) RestoreURL(
+	ctx context.Context, in *urlService.RestoreURLReq) (
+	*urlService.RestoreURLResp, error) {
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		return nil, status.Error(codes.Internal, "context: failed to retrieve user_id")
+	}
+
+	obj := model.RestoreURLReqToCanon(in, userID)
+
+	err := srv.service.RestoreURL(ctx, &obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, pkg.ErrNotFound):
+			return nil, status.Error(codes.NotFound, pkg.ErrNotFound.Error())
+		case errors.Is(err, pkg.ErrAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, pkg.ErrAlreadyExists.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := model.RestoreURLRespFromCanon(obj, srv.config.BaseURL)
 
 	return out, nil
 }
@@ -145,7 +278,7 @@ func (srv *gRPCServer) GetUsersURLs(
 // DeleteUserURLs removes urls created by current user.
 func (srv *gRPCServer) DeleteUserURLs(
 	ctx context.Context, in *urlService.DelUserURLsReq) (
-	*emptypb.Empty, error) {
+	*urlService.DelUserURLsResp, error) {
 
 	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
 	if !ok {
@@ -157,11 +290,14 @@ func (srv *gRPCServer) DeleteUserURLs(
 		return nil, status.Error(codes.InvalidArgument, pkg.ErrInvalidInput.Error())
 	}
 
-	err = srv.service.RemoveUsersURLs(ctx, objs)
+	jobID, err := srv.service.RemoveUsersURLs(ctx, objs)
 	if err != nil {
 		if errors.Is(err, pkg.ErrInvalidInput) {
 			return nil, status.Error(codes.InvalidArgument, pkg.ErrInvalidInput.Error())
 		}
+		if errors.Is(err, pkg.ErrServiceClosed) {
+			return nil, status.Error(codes.Unavailable, pkg.ErrServiceClosed.Error())
+		}
 
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -169,5 +305,63 @@ func (srv *gRPCServer) DeleteUserURLs(
 	header := metadata.New(map[string]string{"x-http-code": strconv.Itoa(http.StatusAccepted)})
 	grpc.SetHeader(ctx, header)
 
-	return &emptypb.Empty{}, nil
+	out := model.DelUserURLsRespFromCanon(jobID)
+
+	return out, nil
+}
+
+// GetDeletionJob returns per url outcomes of deletion job started by current user.
+func (srv *gRPCServer) GetDeletionJob(
+	ctx context.Context, in *urlService.GetDeletionJobReq) (
+	*urlService.GetDeletionJobResp, error) {
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		return nil, status.Error(codes.Internal, "context: failed to retrieve user_id")
+	}
+
+	jobID, err := uuid.Parse(in.GetJob())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, pkg.ErrInvalidInput.Error())
+	}
+
+	job, err := srv.service.GetDeletionJob(ctx, userID, jobID)
+	if err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, pkg.ErrNotFound.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := model.GetDeletionJobRespFromCanon(job)
+
+	return out, nil
+}
+
+// invalidArgumentError converts invalid input error to InvalidArgument status with given message.
+// Input errors with reason are described by BadRequest and ErrorInfo status details.
+func invalidArgumentError(err error, msg string) error {
+	var inputErr *pkg.InputError
+	if !errors.As(err, &inputErr) {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+
+	st, detailsErr := status.New(codes.InvalidArgument, inputErr.Error()).WithDetails(
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: inputErr.Field, Description: inputErr.Message},
+			},
+		},
+		&errdetails.ErrorInfo{
+			Reason:   strings.ToUpper(inputErr.Reason),
+			Domain:   errorDomain,
+			Metadata: map[string]string{"field": inputErr.Field},
+		},
+	)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, inputErr.Error())
+	}
+
+	return st.Err()
 }
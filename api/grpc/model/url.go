@@ -1,37 +1,66 @@
 package model
 
 import (
+	"fmt"
+	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/vstdy/go-shortener/model"
 	"github.com/vstdy/go-shortener/pkg/grpc/url-service"
 )
 
+const (
+	HeaderRealIP           = "x-real-ip"
+	HeaderReferer          = "referer"
+	HeaderUserAgent        = "user-agent"
+	HeaderGatewayUserAgent = "grpcgateway-user-agent"
+)
+
 // newShortcut returns shortcut for object.
-func newShortcut(objID int, baseURL string) string {
-	return baseURL + "/gw/" + strconv.Itoa(objID)
+func newShortcut(obj model.URL, baseURL string) string {
+	return baseURL + "/gw/" + obj.Code
+}
+
+// setExpiration sets optional expiration of canonical model.
+func setExpiration(obj *model.URL, expiresAt *timestamppb.Timestamp, ttl *durationpb.Duration) {
+	if expiresAt != nil {
+		obj.ExpiresAt = expiresAt.AsTime()
+	}
+	if ttl != nil {
+		obj.TTL = ttl.AsDuration()
+	}
 }
 
 // ShortenURL
-// NewShortenURLReq creates new ShortenURLReq model from url.
-func NewShortenURLReq(url string) *urlService.ShortenURLReq {
-	return &urlService.ShortenURLReq{Url: url}
+// NewShortenURLReq creates new ShortenURLReq model from url and optional alias.
+func NewShortenURLReq(url, alias string) *urlService.ShortenURLReq {
+	return &urlService.ShortenURLReq{Url: url, Alias: alias}
 }
 
 // ShortenURLReqToCanon converts gRPC model to canonical model.
 func ShortenURLReqToCanon(in *urlService.ShortenURLReq, userID uuid.UUID) model.URL {
-	return model.URL{
-		UserID: userID,
-		URL:    in.Url,
+	obj := model.URL{
+		Code:         in.GetAlias(),
+		UserID:       userID,
+		URL:          in.Url,
+		MaxClicks:    int(in.GetMaxClicks()),
+		RedirectCode: int(in.GetRedirectCode()),
 	}
+	setExpiration(&obj, in.GetExpiresAt(), in.GetTtl())
+
+	return obj
 }
 
 // ShortenURLRespFromCanon converts canonical model to gRPC model.
 func ShortenURLRespFromCanon(obj model.URL, baseURL string) *urlService.ShortenURLResp {
-	return &urlService.ShortenURLResp{Result: newShortcut(obj.ID, baseURL)}
+	return &urlService.ShortenURLResp{Result: newShortcut(obj, baseURL)}
 }
 
 // ShortenURLsBatch
@@ -52,11 +81,15 @@ func NewShortenURLsBatchReq(urls []string) *urlService.ShortenURLsBatchReq {
 func ShortenURLsBatchReqToCanon(in *urlService.ShortenURLsBatchReq, userID uuid.UUID) []model.URL {
 	var objs []model.URL
 	for _, unit := range in.GetRequest() {
-		objs = append(objs, model.URL{
+		obj := model.URL{
 			CorrelationID: unit.GetCorrelationId(),
 			UserID:        userID,
 			URL:           unit.GetOriginalUrl(),
-		})
+			MaxClicks:     int(unit.GetMaxClicks()),
+			RedirectCode:  int(unit.GetRedirectCode()),
+		}
+		setExpiration(&obj, unit.GetExpiresAt(), unit.GetTtl())
+		objs = append(objs, obj)
 	}
 
 	return objs
@@ -68,7 +101,7 @@ func ShortenURLsBatchRespFromCanon(objs []model.URL, baseURL string) *urlService
 	for _, obj := range objs {
 		resp = append(resp, &urlService.ShortenURLsBatchResp_UrlUnit{
 			CorrelationId: obj.CorrelationID,
-			ShortUrl:      newShortcut(obj.ID, baseURL),
+			ShortUrl:      newShortcut(obj, baseURL),
 		})
 	}
 
@@ -84,17 +117,184 @@ func NewGetOrigURLReq(url, baseURL string) *urlService.GetOrigURLReq {
 }
 
 // GetUsersURLs
+// NewGetUsersURLsReq creates new GetUsersURLsReq model from canonical list params.
+func NewGetUsersURLsReq(params model.ListParams) *urlService.GetUsersURLsReq {
+	req := &urlService.GetUsersURLsReq{
+		Limit:  int32(params.Limit),
+		Cursor: params.Cursor,
+		Search: params.Filter.Search,
+		Domain: params.Filter.Domain,
+		Sort:   string(params.Sort),
+	}
+	if !params.Filter.CreatedFrom.IsZero() {
+		req.CreatedFrom = timestamppb.New(params.Filter.CreatedFrom)
+	}
+	if !params.Filter.CreatedTo.IsZero() {
+		req.CreatedTo = timestamppb.New(params.Filter.CreatedTo)
+	}
+	if params.Desc {
+		req.Order = "desc"
+	}
+
+	return req
+}
+
+// GetUsersURLsReqToCanon converts gRPC model to canonical list params.
+func GetUsersURLsReqToCanon(in *urlService.GetUsersURLsReq) model.ListParams {
+	params := model.ListParams{
+		Cursor: in.GetCursor(),
+		Limit:  int(in.GetLimit()),
+		Filter: model.URLsFilter{
+			Search: in.GetSearch(),
+			Domain: in.GetDomain(),
+		},
+		Sort: model.ListSort(in.GetSort()),
+		Desc: in.GetOrder() == "desc",
+	}
+	if in.GetCreatedFrom() != nil {
+		params.Filter.CreatedFrom = in.GetCreatedFrom().AsTime()
+	}
+	if in.GetCreatedTo() != nil {
+		params.Filter.CreatedTo = in.GetCreatedTo().AsTime()
+	}
+
+	return params
+}
+
 // GetUsersURLsRespFromCanon converts canonical model to gRPC model.
-func GetUsersURLsRespFromCanon(objs []model.URL, baseURL string) *urlService.GetUsersURLsResp {
+func GetUsersURLsRespFromCanon(page model.URLsPage, baseURL string) *urlService.GetUsersURLsResp {
 	var urls []*urlService.GetUsersURLsResp_UrlUnit
-	for _, obj := range objs {
+	for _, obj := range page.URLs {
 		urls = append(urls, &urlService.GetUsersURLsResp_UrlUnit{
 			OriginalUrl: obj.URL,
-			ShortUrl:    newShortcut(obj.ID, baseURL),
+			DisplayUrl:  obj.DisplayURL(),
+			ShortUrl:    newShortcut(obj, baseURL),
+		})
+	}
+
+	return &urlService.GetUsersURLsResp{Response: urls, NextCursor: page.NextCursor}
+}
+
+// GetURLStats
+// statsBuckets keeps supported stats bucket sizes.
+var statsBuckets = map[string]time.Duration{
+	"hour": time.Hour,
+	"day":  24 * time.Hour,
+}
+
+// NewGetURLStatsReq creates new GetURLStatsReq model from short code and bucket size.
+func NewGetURLStatsReq(id, bucket string) *urlService.GetURLStatsReq {
+	return &urlService.GetURLStatsReq{Id: id, Bucket: bucket}
+}
+
+// GetURLStatsReqToCanon converts gRPC model to canonical stats params.
+func GetURLStatsReqToCanon(in *urlService.GetURLStatsReq) model.StatsParams {
+	var params model.StatsParams
+	if in.GetFrom() != nil {
+		params.From = in.GetFrom().AsTime()
+	}
+	if in.GetTo() != nil {
+		params.To = in.GetTo().AsTime()
+	}
+	params.Bucket = statsBuckets[in.GetBucket()]
+
+	return params
+}
+
+// newStatsCounters converts canonical models to gRPC models.
+func newStatsCounters(objs []model.StatsCounter) []*urlService.GetURLStatsResp_Counter {
+	counters := make([]*urlService.GetURLStatsResp_Counter, 0, len(objs))
+	for _, obj := range objs {
+		counters = append(counters, &urlService.GetURLStatsResp_Counter{
+			Value:  obj.Value,
+			Clicks: int64(obj.Clicks),
+		})
+	}
+
+	return counters
+}
+
+// GetURLStatsRespFromCanon converts canonical model to gRPC model.
+func GetURLStatsRespFromCanon(obj model.URLStats) *urlService.GetURLStatsResp {
+	buckets := make([]*urlService.GetURLStatsResp_Bucket, 0, len(obj.Buckets))
+	for _, bucket := range obj.Buckets {
+		buckets = append(buckets, &urlService.GetURLStatsResp_Bucket{
+			Start:  timestamppb.New(bucket.Start),
+			Clicks: int64(bucket.Clicks),
+		})
+	}
+
+	return &urlService.GetURLStatsResp{
+		TotalClicks:    int64(obj.TotalClicks),
+		UniqueVisitors: int64(obj.UniqueVisitors),
+		Buckets:        buckets,
+		TopReferrers:   newStatsCounters(obj.TopReferrers),
+		TopUserAgents:  newStatsCounters(obj.TopUserAgents),
+	}
+}
+
+// UpdateURL
+// NewUpdateURLReq creates new UpdateURLReq model from short code, url and redirect code.
+func NewUpdateURLReq(id, url string, redirectCode int) *urlService.UpdateURLReq {
+	return &urlService.UpdateURLReq{Id: id, Url: url, RedirectCode: int32(redirectCode)}
+}
+
+// UpdateURLReqToCanon converts gRPC model to canonical model.
+func UpdateURLReqToCanon(in *urlService.UpdateURLReq, userID uuid.UUID) model.URL {
+	return model.URL{
+		Code:         in.GetId(),
+		UserID:       userID,
+		URL:          in.GetUrl(),
+		RedirectCode: int(in.GetRedirectCode()),
+	}
+}
+
+// UpdateURLRespFromCanon converts canonical model to gRPC model.
+func UpdateURLRespFromCanon(obj model.URL, baseURL string) *urlService.UpdateURLResp {
+	return &urlService.UpdateURLResp{
+		ShortUrl:    newShortcut(obj, baseURL),
+		OriginalUrl: obj.URL,
+		DisplayUrl:  obj.DisplayURL(),
+	}
+}
+
+// GetUsersDeletedURLs
+// GetUsersDeletedURLsRespFromCanon converts canonical model to gRPC model.
+func GetUsersDeletedURLsRespFromCanon(objs []model.URL, baseURL string) *urlService.GetUsersDeletedURLsResp {
+	var urls []*urlService.GetUsersDeletedURLsResp_UrlUnit
+	for _, obj := range objs {
+		urls = append(urls, &urlService.GetUsersDeletedURLsResp_UrlUnit{
+			OriginalUrl: obj.URL,
+			DisplayUrl:  obj.DisplayURL(),
+			ShortUrl:    newShortcut(obj, baseURL),
+			DeletedAt:   timestamppb.New(obj.DeletedAt),
 		})
 	}
 
-	return &urlService.GetUsersURLsResp{Response: urls}
+	return &urlService.GetUsersDeletedURLsResp{Response: urls}
+}
+
+// RestoreURL
+// NewRestoreURLReq creates new RestoreURLReq model from short code.
+func NewRestoreURLReq(id string) *urlService.RestoreURLReq {
+	return &urlService.RestoreURLReq{Id: id}
+}
+
+// RestoreURLReqToCanon converts gRPC model to canonical model.
+func RestoreURLReqToCanon(in *urlService.RestoreURLReq, userID uuid.UUID) model.URL {
+	return model.URL{
+		Code:   in.GetId(),
+		UserID: userID,
+	}
+}
+
+// RestoreURLRespFromCanon converts canonical model to gRPC model.
+func RestoreURLRespFromCanon(obj model.URL, baseURL string) *urlService.RestoreURLResp {
+	return &urlService.RestoreURLResp{
+		ShortUrl:    newShortcut(obj, baseURL),
+		OriginalUrl: obj.URL,
+		DisplayUrl:  obj.DisplayURL(),
+	}
 }
 
 // DeleteUserURLs
@@ -106,17 +306,80 @@ func NewDelUserURLsReq(ids []string) *urlService.DelUserURLsReq {
 // DelUserURLsReqToCanon converts gRPC model to canonical model.
 func DelUserURLsReqToCanon(in *urlService.DelUserURLsReq, userID uuid.UUID) ([]model.URL, error) {
 	var objs []model.URL
-	for _, idRaw := range in.GetIds() {
-		id, err := strconv.Atoi(idRaw)
-		if err != nil {
-			return nil, err
+	for _, id := range in.GetIds() {
+		if id == "" {
+			return nil, fmt.Errorf("id: empty")
 		}
 
 		objs = append(objs, model.URL{
-			ID:     id,
+			Code:   id,
 			UserID: userID,
 		})
 	}
 
 	return objs, nil
 }
+
+// DelUserURLsRespFromCanon converts deletion job id to gRPC model.
+func DelUserURLsRespFromCanon(jobID uuid.UUID) *urlService.DelUserURLsResp {
+	return &urlService.DelUserURLsResp{Job: jobID.String()}
+}
+
+// GetDeletionJob
+// NewGetDeletionJobReq creates new GetDeletionJobReq model from deletion job id.
+func NewGetDeletionJobReq(job string) *urlService.GetDeletionJobReq {
+	return &urlService.GetDeletionJobReq{Job: job}
+}
+
+// GetDeletionJobRespFromCanon converts canonical model to gRPC model.
+// The job is done once every its deletion is done or failed.
+func GetDeletionJobRespFromCanon(obj model.DeletionJob) *urlService.GetDeletionJobResp {
+	jobStatus := "pending"
+	if obj.IsDone() {
+		jobStatus = "done"
+	}
+
+	urls := make([]*urlService.GetDeletionJobResp_UrlUnit, 0, len(obj.Deletions))
+	for _, deletion := range obj.Deletions {
+		urls = append(urls, &urlService.GetDeletionJobResp_UrlUnit{
+			Id:     deletion.Code,
+			Status: string(deletion.Outcome()),
+		})
+	}
+
+	return &urlService.GetDeletionJobResp{
+		Job:       obj.ID.String(),
+		Status:    jobStatus,
+		CreatedAt: timestamppb.New(obj.CreatedAt),
+		Urls:      urls,
+	}
+}
+
+// NewClickFromMD creates canonical click model from request metadata.
+// Gateway requests carry client IP and referrer set by metadata annotator,
+// peerAddr is used for direct gRPC calls.
+func NewClickFromMD(urlID int, md metadata.MD, peerAddr string) model.Click {
+	get := func(keys ...string) string {
+		for _, key := range keys {
+			if values := md.Get(key); len(values) > 0 && values[0] != "" {
+				return values[0]
+			}
+		}
+		return ""
+	}
+
+	ip := get(HeaderRealIP)
+	if ip == "" {
+		ip = peerAddr
+		if host, _, err := net.SplitHostPort(peerAddr); err == nil {
+			ip = host
+		}
+	}
+
+	return model.Click{
+		URLID:     urlID,
+		Referrer:  get(HeaderReferer),
+		UserAgent: get(HeaderGatewayUserAgent, HeaderUserAgent),
+		IP:        ip,
+	}
+}
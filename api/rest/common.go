@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"strconv"
+	"net/http"
 
 	"github.com/google/uuid"
 
@@ -42,7 +42,7 @@ func (h Handler) plainURLResponse(ctx context.Context, userID uuid.UUID, body []
 		return nil, svcErr
 	}
 
-	return []byte(h.config.BaseURL + "/" + strconv.Itoa(obj.ID)), svcErr
+	return []byte(h.config.BaseURL + "/" + obj.Code), svcErr
 }
 
 func (h Handler) urlsBatchResponse(ctx context.Context, userID uuid.UUID, body []byte) ([]byte, error) {
@@ -70,3 +70,25 @@ func (h Handler) urlsBatchResponse(ctx context.Context, userID uuid.UUID, body [
 
 	return res, svcErr
 }
+
+// invalidInputError responds with 400 Bad Request.
+// Input errors with reason are described in application/json body, others in text/plain body.
+func invalidInputError(w http.ResponseWriter, err error) {
+	var inputErr *pkg.InputError
+	if !errors.As(err, &inputErr) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := json.Marshal(model.NewInputErrorResponse(inputErr))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	if _, err = w.Write(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
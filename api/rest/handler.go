@@ -6,7 +6,6 @@ import (
 	"errors"
 	"io"
 	"net/http"
-	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -22,6 +21,8 @@ import (
 
 const (
 	serviceName = "Shortener server"
+
+	headerNextCursor = "X-Next-Cursor"
 )
 
 // Handler keeps handler dependencies.
@@ -72,7 +73,12 @@ func (h Handler) shortenURL(w http.ResponseWriter, r *http.Request) {
 	}
 	if err != nil {
 		if errors.Is(err, pkg.ErrInvalidInput) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			invalidInputError(w, err)
+			return
+		}
+
+		if errors.Is(err, pkg.ErrCodeTaken) {
+			http.Error(w, err.Error(), http.StatusConflict)
 			return
 		}
 
@@ -123,7 +129,7 @@ func (h Handler) shortenURLsBatch(w http.ResponseWriter, r *http.Request) {
 	res, err := h.urlsBatchResponse(ctx, userID, body)
 	if err != nil {
 		if errors.Is(err, pkg.ErrInvalidInput) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			invalidInputError(w, err)
 			return
 		}
 
@@ -156,13 +162,7 @@ func (h Handler) getOriginalURL(w http.ResponseWriter, r *http.Request) {
 	ctx, span := tracing.StartSpanFromCtx(ctx, "Getting original URL")
 	defer tracing.FinishSpan(span, nil)
 
-	urlID, err := strconv.Atoi(chi.URLParam(r, "id"))
-	if err != nil {
-		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-		return
-	}
-
-	url, err := h.service.GetURL(ctx, urlID)
+	obj, err := h.service.GetURL(ctx, chi.URLParam(r, "id"))
 	if err != nil {
 		if errors.Is(err, pkg.ErrInvalidInput) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
@@ -174,13 +174,17 @@ func (h Handler) getOriginalURL(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if url == "" {
+	if obj.URL == "" {
 		w.WriteHeader(http.StatusGone)
 		return
 	}
 
-	w.Header().Set("Location", url)
-	w.WriteHeader(http.StatusTemporaryRedirect)
+	if err = h.service.AddClick(ctx, model.NewClickFromRequest(obj.ID, r)); err != nil {
+		logger.Warn().Err(err).Msg("Recording click:")
+	}
+
+	w.Header().Set("Location", obj.URL)
+	w.WriteHeader(obj.RedirectCode)
 }
 
 // getUsersURLs returns urls created by current user.
@@ -195,19 +199,30 @@ func (h Handler) getUsersURLs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	urls, err := h.service.GetUsersURLs(ctx, userID)
+	params, err := model.NewListParamsFromQuery(r.URL.Query())
 	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page, err := h.service.GetUsersURLs(ctx, userID, params)
+	if err != nil {
+		if errors.Is(err, pkg.ErrInvalidInput) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
 		logger.Warn().Err(err).Msg("Getting user URLs:")
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	if len(urls) == 0 {
+	if len(page.URLs) == 0 {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
-	userURLs := model.NewUserURLsFromCanon(urls, h.config.BaseURL)
+	userURLs := model.NewUserURLsFromCanon(page.URLs, h.config.BaseURL)
 
 	res, err := json.Marshal(userURLs)
 	if err != nil {
@@ -215,6 +230,138 @@ func (h Handler) getUsersURLs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if page.NextCursor != "" {
+		w.Header().Set(headerNextCursor, page.NextCursor)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// updateURL updates url created by current user.
+func (h Handler) updateURL(w http.ResponseWriter, r *http.Request) {
+	ctx, logger := h.Logger(r.Context())
+	ctx, span := tracing.StartSpanFromCtx(ctx, "Updating URL")
+	defer tracing.FinishSpan(span, nil)
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		http.Error(w, "context: failed to retrieve user_id", http.StatusInternalServerError)
+		return
+	}
+
+	var req model.UpdateURLRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	obj := req.ToCanonical(chi.URLParam(r, "id"), userID)
+	err = h.service.UpdateURL(ctx, &obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrInvalidInput):
+			invalidInputError(w, err)
+		case errors.Is(err, pkg.ErrNotFound):
+			http.Error(w, pkg.ErrNotFound.Error(), http.StatusNotFound)
+		case errors.Is(err, pkg.ErrAlreadyExists):
+			http.Error(w, pkg.ErrAlreadyExists.Error(), http.StatusConflict)
+		default:
+			logger.Warn().Err(err).Msg("Updating URL:")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	res, err := json.Marshal(model.NewUserURLFromCanon(obj, h.config.BaseURL))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// getUsersDeletedURLs returns urls deleted by current user within restore grace period.
+func (h Handler) getUsersDeletedURLs(w http.ResponseWriter, r *http.Request) {
+	ctx, logger := h.Logger(r.Context())
+	ctx, span := tracing.StartSpanFromCtx(ctx, "Getting user deleted URLs")
+	defer tracing.FinishSpan(span, nil)
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		http.Error(w, "context: failed to retrieve user_id", http.StatusInternalServerError)
+		return
+	}
+
+	urls, err := h.service.GetUsersDeletedURLs(ctx, userID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Getting user deleted URLs:")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if len(urls) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	res, err := json.Marshal(model.NewDeletedURLsFromCanon(urls, h.config.BaseURL))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// restoreURL restores url deleted by current user.
+func (h Handler) restoreURL(w http.ResponseWriter, r *http.Request) {
+	ctx, logger := h.Logger(r.Context())
+	ctx, span := tracing.StartSpanFromCtx(ctx, "Restoring URL")
+	defer tracing.FinishSpan(span, nil)
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		http.Error(w, "context: failed to retrieve user_id", http.StatusInternalServerError)
+		return
+	}
+
+	obj := model.URLToRestoreToCanon(chi.URLParam(r, "id"), userID)
+	err := h.service.RestoreURL(ctx, &obj)
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrInvalidInput):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, pkg.ErrNotFound):
+			http.Error(w, pkg.ErrNotFound.Error(), http.StatusNotFound)
+		case errors.Is(err, pkg.ErrAlreadyExists):
+			http.Error(w, pkg.ErrAlreadyExists.Error(), http.StatusConflict)
+		default:
+			logger.Warn().Err(err).Msg("Restoring URL:")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	res, err := json.Marshal(model.NewUserURLFromCanon(obj, h.config.BaseURL))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	if _, err := w.Write(res); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -248,19 +395,125 @@ func (h Handler) deleteUserURLs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.service.RemoveUsersURLs(ctx, objs)
+	jobID, err := h.service.RemoveUsersURLs(ctx, objs)
 	if err != nil {
 		if errors.Is(err, pkg.ErrInvalidInput) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		if errors.Is(err, pkg.ErrServiceClosed) {
+			http.Error(w, pkg.ErrServiceClosed.Error(), http.StatusServiceUnavailable)
+			return
+		}
 
 		logger.Warn().Err(err).Msg("Deleting user URLs:")
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
+	res, err := json.Marshal(model.NewDeletionJobAccepted(jobID))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/api/user/urls/deletions/"+jobID.String())
 	w.WriteHeader(http.StatusAccepted)
+	if _, err := w.Write(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// getDeletionJob returns per url outcomes of deletion job started by current user.
+func (h Handler) getDeletionJob(w http.ResponseWriter, r *http.Request) {
+	ctx, logger := h.Logger(r.Context())
+	ctx, span := tracing.StartSpanFromCtx(ctx, "Getting deletion job")
+	defer tracing.FinishSpan(span, nil)
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		http.Error(w, "context: failed to retrieve user_id", http.StatusInternalServerError)
+		return
+	}
+
+	jobID, err := uuid.Parse(chi.URLParam(r, "job"))
+	if err != nil {
+		http.Error(w, "job: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	job, err := h.service.GetDeletionJob(ctx, userID, jobID)
+	if err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			http.Error(w, pkg.ErrNotFound.Error(), http.StatusNotFound)
+			return
+		}
+
+		logger.Warn().Err(err).Msg("Getting deletion job:")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	res, err := json.Marshal(model.NewDeletionJobRespFromCanon(job))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// getURLStats returns clicks statistics of url created by current user.
+func (h Handler) getURLStats(w http.ResponseWriter, r *http.Request) {
+	ctx, logger := h.Logger(r.Context())
+	ctx, span := tracing.StartSpanFromCtx(ctx, "Getting URL stats")
+	defer tracing.FinishSpan(span, nil)
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		http.Error(w, "context: failed to retrieve user_id", http.StatusInternalServerError)
+		return
+	}
+
+	params, err := model.NewStatsParamsFromQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stats, err := h.service.GetURLStats(ctx, userID, chi.URLParam(r, "id"), params)
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrInvalidInput):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, pkg.ErrNotFound):
+			http.Error(w, pkg.ErrNotFound.Error(), http.StatusNotFound)
+		case errors.Is(err, pkg.ErrForbidden):
+			http.Error(w, pkg.ErrForbidden.Error(), http.StatusForbidden)
+		default:
+			logger.Warn().Err(err).Msg("Getting URL stats:")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	res, err := json.Marshal(model.NewURLStatsRespFromCanon(stats))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 }
 
 // ping checks connection to database.
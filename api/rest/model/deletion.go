@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+const (
+	deletionJobPending = "pending"
+	deletionJobDone    = "done"
+)
+
+type (
+	DeletionJobAccepted struct {
+		Job string `json:"job"`
+	}
+
+	deletionOutcome struct {
+		ID     string               `json:"id"`
+		Status model.DeletionStatus `json:"status"`
+	}
+
+	DeletionJobResponse struct {
+		Job       string            `json:"job"`
+		Status    string            `json:"status"`
+		CreatedAt time.Time         `json:"created_at"`
+		URLs      []deletionOutcome `json:"urls"`
+	}
+)
+
+// NewDeletionJobAccepted creates DeletionJobAccepted object from deletion job id.
+func NewDeletionJobAccepted(jobID uuid.UUID) DeletionJobAccepted {
+	return DeletionJobAccepted{Job: jobID.String()}
+}
+
+// NewDeletionJobRespFromCanon creates DeletionJobResponse object from canonical model.
+// The job is done once every its deletion is done or failed.
+func NewDeletionJobRespFromCanon(obj model.DeletionJob) DeletionJobResponse {
+	status := deletionJobPending
+	if obj.IsDone() {
+		status = deletionJobDone
+	}
+
+	urls := make([]deletionOutcome, 0, len(obj.Deletions))
+	for _, deletion := range obj.Deletions {
+		urls = append(urls, deletionOutcome{ID: deletion.Code, Status: deletion.Outcome()})
+	}
+
+	return DeletionJobResponse{
+		Job:       obj.ID.String(),
+		Status:    status,
+		CreatedAt: obj.CreatedAt,
+		URLs:      urls,
+	}
+}
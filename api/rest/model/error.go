@@ -0,0 +1,22 @@
+package model
+
+import (
+	"github.com/vstdy/go-shortener/pkg"
+)
+
+type InputErrorResponse struct {
+	Error   string `json:"error"`
+	Field   string `json:"field"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// NewInputErrorResponse creates InputErrorResponse object from input error.
+func NewInputErrorResponse(err *pkg.InputError) InputErrorResponse {
+	return InputErrorResponse{
+		Error:   pkg.ErrInvalidInput.Error(),
+		Field:   err.Field,
+		Reason:  err.Reason,
+		Message: err.Message,
+	}
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+// NewListParamsFromQuery creates canonical list params from request query.
+// Creation time range is set in RFC 3339 format, order is either asc or desc.
+func NewListParamsFromQuery(query url.Values) (model.ListParams, error) {
+	params := model.ListParams{
+		Cursor: query.Get("cursor"),
+		Filter: model.URLsFilter{
+			Search: query.Get("search"),
+			Domain: query.Get("domain"),
+		},
+		Sort: model.ListSort(query.Get("sort")),
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			return model.ListParams{}, fmt.Errorf("limit: %v", err)
+		}
+		params.Limit = value
+	}
+
+	for key, dst := range map[string]*time.Time{
+		"created_from": &params.Filter.CreatedFrom,
+		"created_to":   &params.Filter.CreatedTo,
+	} {
+		value := query.Get(key)
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return model.ListParams{}, fmt.Errorf("%s: %v", key, err)
+		}
+		*dst = t
+	}
+
+	switch order := query.Get("order"); order {
+	case "", "asc":
+	case "desc":
+		params.Desc = true
+	default:
+		return model.ListParams{}, fmt.Errorf("order: unsupported value %q", order)
+	}
+
+	return params, nil
+}
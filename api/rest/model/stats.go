@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+// statsBuckets keeps supported stats bucket sizes.
+var statsBuckets = map[string]time.Duration{
+	"hour": time.Hour,
+	"day":  24 * time.Hour,
+}
+
+// NewStatsParamsFromQuery creates canonical stats params from request query.
+// Time range is set in RFC 3339 format, bucket is either hour or day.
+func NewStatsParamsFromQuery(query url.Values) (model.StatsParams, error) {
+	var params model.StatsParams
+
+	for key, dst := range map[string]*time.Time{"from": &params.From, "to": &params.To} {
+		value := query.Get(key)
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return model.StatsParams{}, fmt.Errorf("%s: %v", key, err)
+		}
+		*dst = t
+	}
+
+	if bucket := query.Get("bucket"); bucket != "" {
+		size, ok := statsBuckets[bucket]
+		if !ok {
+			return model.StatsParams{}, fmt.Errorf("bucket: unsupported value %q", bucket)
+		}
+		params.Bucket = size
+	}
+
+	return params, nil
+}
+
+type (
+	statsBucket struct {
+		Start  time.Time `json:"start"`
+		Clicks int       `json:"clicks"`
+	}
+
+	statsCounter struct {
+		Value  string `json:"value"`
+		Clicks int    `json:"clicks"`
+	}
+
+	URLStatsResponse struct {
+		TotalClicks    int            `json:"total_clicks"`
+		UniqueVisitors int            `json:"unique_visitors"`
+		Buckets        []statsBucket  `json:"buckets"`
+		TopReferrers   []statsCounter `json:"top_referrers"`
+		TopUserAgents  []statsCounter `json:"top_user_agents"`
+	}
+)
+
+// newStatsCounters creates array of statsCounter objects from array of canonical models.
+func newStatsCounters(objs []model.StatsCounter) []statsCounter {
+	counters := make([]statsCounter, 0, len(objs))
+	for _, obj := range objs {
+		counters = append(counters, statsCounter{Value: obj.Value, Clicks: obj.Clicks})
+	}
+
+	return counters
+}
+
+// NewURLStatsRespFromCanon creates URLStatsResponse object from canonical model.
+func NewURLStatsRespFromCanon(obj model.URLStats) URLStatsResponse {
+	buckets := make([]statsBucket, 0, len(obj.Buckets))
+	for _, bucket := range obj.Buckets {
+		buckets = append(buckets, statsBucket{Start: bucket.Start, Clicks: bucket.Clicks})
+	}
+
+	return URLStatsResponse{
+		TotalClicks:    obj.TotalClicks,
+		UniqueVisitors: obj.UniqueVisitors,
+		Buckets:        buckets,
+		TopReferrers:   newStatsCounters(obj.TopReferrers),
+		TopUserAgents:  newStatsCounters(obj.TopUserAgents),
+	}
+}
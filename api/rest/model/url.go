@@ -1,7 +1,10 @@
 package model
 
 import (
-	"strconv"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -9,20 +12,42 @@ import (
 )
 
 // newShortcut returns shortcut for object.
-func newShortcut(objID int, baseURL string) string {
-	return baseURL + "/" + strconv.Itoa(objID)
+func newShortcut(obj model.URL, baseURL string) string {
+	return baseURL + "/" + obj.Code
+}
+
+// linkOptions defines optional url settings, TTL is set in seconds.
+type linkOptions struct {
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	TTL          int64      `json:"ttl,omitempty"`
+	MaxClicks    int        `json:"max_clicks,omitempty"`
+	RedirectCode int        `json:"redirect_code,omitempty"`
+}
+
+// setCanonical sets optional settings of canonical model.
+func (o linkOptions) setCanonical(obj *model.URL) {
+	if o.ExpiresAt != nil {
+		obj.ExpiresAt = *o.ExpiresAt
+	}
+	obj.TTL = time.Duration(o.TTL) * time.Second
+	obj.MaxClicks = o.MaxClicks
+	obj.RedirectCode = o.RedirectCode
 }
 
 type AddURLRequest struct {
-	URL string `json:"url"`
+	URL   string `json:"url"`
+	Alias string `json:"alias,omitempty"`
+	linkOptions
 }
 
 // ToCanonical converts API model to canonical model.
 func (u AddURLRequest) ToCanonical(userID uuid.UUID) model.URL {
 	obj := model.URL{
+		Code:   u.Alias,
 		UserID: userID,
 		URL:    u.URL,
 	}
+	u.setCanonical(&obj)
 
 	return obj
 }
@@ -38,13 +63,14 @@ type AddURLResponse struct {
 
 // NewURLRespFromCanon creates AddURLResponse object from canonical model.
 func NewURLRespFromCanon(obj model.URL, baseURL string) AddURLResponse {
-	return AddURLResponse{Result: newShortcut(obj.ID, baseURL)}
+	return AddURLResponse{Result: newShortcut(obj, baseURL)}
 }
 
 type (
 	urlInBatch struct {
 		CorrelationID string `json:"correlation_id"`
 		OriginalURL   string `json:"original_url"`
+		linkOptions
 	}
 
 	AddURLsBatchReq []urlInBatch
@@ -54,11 +80,13 @@ type (
 func (u AddURLsBatchReq) ToCanonical(userID uuid.UUID) ([]model.URL, error) {
 	var objs []model.URL
 	for _, url := range u {
-		objs = append(objs, model.URL{
+		obj := model.URL{
 			CorrelationID: url.CorrelationID,
 			UserID:        userID,
 			URL:           url.OriginalURL,
-		})
+		}
+		url.setCanonical(&obj)
+		objs = append(objs, obj)
 	}
 
 	return objs, nil
@@ -76,7 +104,7 @@ func NewURLsBatchRespFromCanon(objs []model.URL, baseURL string) []AddURLsBatchR
 	for _, obj := range objs {
 		urls = append(urls, AddURLsBatchResp{
 			CorrelationID: obj.CorrelationID,
-			ShortURL:      newShortcut(obj.ID, baseURL),
+			ShortURL:      newShortcut(obj, baseURL),
 		})
 	}
 
@@ -86,6 +114,16 @@ func NewURLsBatchRespFromCanon(objs []model.URL, baseURL string) []AddURLsBatchR
 type UserURL struct {
 	ShortURL    string `json:"short_url"`
 	OriginalURL string `json:"original_url"`
+	DisplayURL  string `json:"display_url"`
+}
+
+// NewUserURLFromCanon creates UserURL object from canonical model.
+func NewUserURLFromCanon(obj model.URL, baseURL string) UserURL {
+	return UserURL{
+		ShortURL:    newShortcut(obj, baseURL),
+		OriginalURL: obj.URL,
+		DisplayURL:  obj.DisplayURL(),
+	}
 }
 
 // NewUserURLsFromCanon creates array of UserURL objects
@@ -93,30 +131,87 @@ type UserURL struct {
 func NewUserURLsFromCanon(objs []model.URL, baseURL string) []UserURL {
 	var userURLs []UserURL
 	for _, obj := range objs {
-		userURLs = append(userURLs,
-			UserURL{
-				ShortURL:    newShortcut(obj.ID, baseURL),
-				OriginalURL: obj.URL,
-			})
+		userURLs = append(userURLs, NewUserURLFromCanon(obj, baseURL))
 	}
 
 	return userURLs
 }
 
+type DeletedURL struct {
+	ShortURL    string    `json:"short_url"`
+	OriginalURL string    `json:"original_url"`
+	DisplayURL  string    `json:"display_url"`
+	DeletedAt   time.Time `json:"deleted_at"`
+}
+
+// NewDeletedURLsFromCanon creates array of DeletedURL objects
+// from array of canonical models.
+func NewDeletedURLsFromCanon(objs []model.URL, baseURL string) []DeletedURL {
+	var deletedURLs []DeletedURL
+	for _, obj := range objs {
+		deletedURLs = append(deletedURLs, DeletedURL{
+			ShortURL:    newShortcut(obj, baseURL),
+			OriginalURL: obj.URL,
+			DisplayURL:  obj.DisplayURL(),
+			DeletedAt:   obj.DeletedAt,
+		})
+	}
+
+	return deletedURLs
+}
+
+type UpdateURLRequest struct {
+	URL          string `json:"url,omitempty"`
+	RedirectCode int    `json:"redirect_code,omitempty"`
+}
+
+// ToCanonical converts API model to canonical model.
+func (u UpdateURLRequest) ToCanonical(code string, userID uuid.UUID) model.URL {
+	return model.URL{
+		Code:         code,
+		UserID:       userID,
+		URL:          u.URL,
+		RedirectCode: u.RedirectCode,
+	}
+}
+
+// URLToRestoreToCanon creates canonical model from id.
+func URLToRestoreToCanon(id string, userID uuid.UUID) model.URL {
+	return model.URL{
+		Code:   id,
+		UserID: userID,
+	}
+}
+
 // URLsToDelToCanon creates array of canonical models from array of ids.
 func URLsToDelToCanon(ids []string, userID uuid.UUID) ([]model.URL, error) {
 	var objs []model.URL
-	for _, idRaw := range ids {
-		id, err := strconv.Atoi(idRaw)
-		if err != nil {
-			return nil, err
+	for _, id := range ids {
+		if id == "" {
+			return nil, fmt.Errorf("id: empty")
 		}
 
 		objs = append(objs, model.URL{
-			ID:     id,
+			Code:   id,
 			UserID: userID,
 		})
 	}
 
 	return objs, nil
 }
+
+// NewClickFromRequest creates canonical click model from redirect request.
+// Client IP is set by RealIP middleware.
+func NewClickFromRequest(urlID int, r *http.Request) model.Click {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return model.Click{
+		URLID:     urlID,
+		Referrer:  r.Referer(),
+		UserAgent: r.UserAgent(),
+		IP:        ip,
+	}
+}
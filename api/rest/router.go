@@ -38,7 +38,12 @@ func NewRouter(svc shortener.Service, config Config) (chi.Router, error) {
 			r.Post("/shorten", h.shortenURL)
 			r.Post("/shorten/batch", h.shortenURLsBatch)
 			r.Get("/user/urls", h.getUsersURLs)
+			r.Get("/user/urls/{id}/stats", h.getURLStats)
+			r.Patch("/user/urls/{id}", h.updateURL)
+			r.Get("/user/urls/trash", h.getUsersDeletedURLs)
+			r.Post("/user/urls/{id}/restore", h.restoreURL)
 			r.Delete("/user/urls", h.deleteUserURLs)
+			r.Get("/user/urls/deletions/{job}", h.getDeletionJob)
 		})
 	})
 
@@ -47,7 +52,7 @@ func NewRouter(svc shortener.Service, config Config) (chi.Router, error) {
 		return nil, fmt.Errorf("building gRPC-gateway mux: %s", err)
 	}
 
-	r.Mount("/gw", mux)
+	r.With(middleware.RealIP).Mount("/gw", mux)
 
 	return r, nil
 }
@@ -0,0 +1,133 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	serviceMock "github.com/vstdy/go-shortener/service/shortener/mock"
+)
+
+func (s *TestSuite) TestServer_getURLStats() {
+	type request struct {
+		method string
+		path   string
+	}
+
+	type expected struct {
+		code        int
+		body        string
+		contentType string
+	}
+
+	type testCase struct {
+		name         string
+		prepareMocks func(ServiceMock *serviceMock.MockService)
+		request      request
+		expected     expected
+	}
+
+	from := time.Date(2022, 2, 15, 0, 0, 0, 0, time.UTC)
+
+	testCases := []testCase{
+		{
+			name:         "Fail: invalid query",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/a1B2c3D/stats?bucket=week",
+			},
+			expected: expected{
+				code:        http.StatusBadRequest,
+				body:        "bucket: unsupported value \"week\"\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "Fail: url not found",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				ServiceMock.EXPECT().
+					GetURLStats(gomock.Any(), s.userID, "a1B2c3D", model.StatsParams{}).
+					Return(model.URLStats{}, pkg.ErrNotFound)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/a1B2c3D/stats",
+			},
+			expected: expected{
+				code:        http.StatusNotFound,
+				body:        "object not found\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "Fail: url of another user",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				ServiceMock.EXPECT().
+					GetURLStats(gomock.Any(), s.userID, "a1B2c3D", model.StatsParams{}).
+					Return(model.URLStats{}, pkg.ErrForbidden)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/a1B2c3D/stats",
+			},
+			expected: expected{
+				code:        http.StatusForbidden,
+				body:        "access denied\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "OK",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				params := model.StatsParams{
+					From:   from,
+					To:     from.Add(2 * time.Hour),
+					Bucket: time.Hour,
+				}
+
+				ServiceMock.EXPECT().
+					GetURLStats(gomock.Any(), s.userID, "a1B2c3D", params).
+					Return(model.URLStats{
+						TotalClicks:    2,
+						UniqueVisitors: 1,
+						Buckets: []model.StatsBucket{
+							{Start: from, Clicks: 2},
+							{Start: from.Add(time.Hour)},
+						},
+						TopReferrers:  []model.StatsCounter{{Value: "https://a.com/", Clicks: 2}},
+						TopUserAgents: []model.StatsCounter{{Value: "curl", Clicks: 2}},
+					}, nil)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/a1B2c3D/stats?from=2022-02-15T00:00:00Z&to=2022-02-15T02:00:00Z&bucket=hour",
+			},
+			expected: expected{
+				code: http.StatusOK,
+				body: `{"total_clicks":2,"unique_visitors":1,` +
+					`"buckets":[{"start":"2022-02-15T00:00:00Z","clicks":2},{"start":"2022-02-15T01:00:00Z","clicks":0}],` +
+					`"top_referrers":[{"value":"https://a.com/","clicks":2}],` +
+					`"top_user_agents":[{"value":"curl","clicks":2}]}`,
+				contentType: "application/json",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.prepareMocks(s.svcMock)
+
+			resp, body := s.testRequest(
+				tc.request.method, tc.request.path, "", "")
+			defer resp.Body.Close()
+
+			s.Assert().Equal(tc.expected.code, resp.StatusCode)
+			s.Assert().Equal(tc.expected.body, body)
+			s.Assert().Equal(tc.expected.contentType, resp.Header.Get("Content-Type"))
+		})
+	}
+}
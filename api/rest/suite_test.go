@@ -66,9 +66,15 @@ func (s *TestSuite) TearDownSuite() {
 }
 
 func (s TestSuite) testRequest(method, path, body, contentType string) (*http.Response, string) {
+	return s.testRequestWithHeaders(method, path, body, map[string]string{"Content-Type": contentType})
+}
+
+func (s TestSuite) testRequestWithHeaders(method, path, body string, headers map[string]string) (*http.Response, string) {
 	req, err := http.NewRequest(method, s.srv.URL+path, strings.NewReader(body))
 	s.Require().NoError(err)
-	req.Header.Set("Content-Type", contentType)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 	req.AddCookie(s.cookie)
 
 	resp, err := s.client.Do(req)
@@ -0,0 +1,201 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	serviceMock "github.com/vstdy/go-shortener/service/shortener/mock"
+)
+
+func (s *TestSuite) TestServer_getUsersDeletedURLs() {
+	type request struct {
+		method string
+		path   string
+	}
+
+	type expected struct {
+		code        int
+		body        string
+		contentType string
+	}
+
+	type testCase struct {
+		name         string
+		prepareMocks func(ServiceMock *serviceMock.MockService)
+		request      request
+		expected     expected
+	}
+	testCases := []testCase{
+		{
+			name: "OK: no content",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				ServiceMock.EXPECT().
+					GetUsersDeletedURLs(gomock.Any(), s.userID).
+					Return(nil, nil)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/trash",
+			},
+			expected: expected{
+				code:        http.StatusNoContent,
+				body:        "",
+				contentType: "",
+			},
+		},
+		{
+			name: "OK",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				output := []model.URL{
+					{
+						ID:        1,
+						Code:      "a1B2c3D",
+						UserID:    s.userID,
+						URL:       "https://lengthy-url.com/",
+						DeletedAt: time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC),
+					},
+				}
+
+				ServiceMock.EXPECT().
+					GetUsersDeletedURLs(gomock.Any(), s.userID).
+					Return(output, nil)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/trash",
+			},
+			expected: expected{
+				code: http.StatusOK,
+				body: `[{"short_url":"` + s.config.BaseURL + `/a1B2c3D","original_url":"https://lengthy-url.com/",` +
+					`"display_url":"https://lengthy-url.com/","deleted_at":"2022-05-01T12:00:00Z"}]`,
+				contentType: "application/json",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.prepareMocks(s.svcMock)
+
+			resp, body := s.testRequest(
+				tc.request.method, tc.request.path, "", "")
+			defer resp.Body.Close()
+
+			s.Assert().Equal(tc.expected.code, resp.StatusCode)
+			s.Assert().Equal(tc.expected.body, body)
+			s.Assert().Equal(tc.expected.contentType, resp.Header.Get("Content-Type"))
+		})
+	}
+}
+
+func (s *TestSuite) TestServer_restoreURL() {
+	type request struct {
+		method string
+		path   string
+	}
+
+	type expected struct {
+		code        int
+		body        string
+		contentType string
+	}
+
+	type testCase struct {
+		name         string
+		prepareMocks func(ServiceMock *serviceMock.MockService)
+		request      request
+		expected     expected
+	}
+	testCases := []testCase{
+		{
+			name: "Fail: url not in trash",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: s.userID,
+				}
+
+				ServiceMock.EXPECT().
+					RestoreURL(gomock.Any(), &input).
+					Return(pkg.ErrNotFound)
+			},
+			request: request{
+				method: http.MethodPost,
+				path:   "/api/user/urls/a1B2c3D/restore",
+			},
+			expected: expected{
+				code:        http.StatusNotFound,
+				body:        "object not found\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "Fail: url is shortened again",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: s.userID,
+				}
+
+				ServiceMock.EXPECT().
+					RestoreURL(gomock.Any(), &input).
+					Return(pkg.ErrAlreadyExists)
+			},
+			request: request{
+				method: http.MethodPost,
+				path:   "/api/user/urls/a1B2c3D/restore",
+			},
+			expected: expected{
+				code:        http.StatusConflict,
+				body:        "object exists in the DB\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "OK",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: s.userID,
+				}
+
+				ServiceMock.EXPECT().
+					RestoreURL(gomock.Any(), &input).
+					Do(func(ctx context.Context, obj *model.URL) {
+						obj.ID = 1
+						obj.URL = "https://lengthy-url.com/"
+					}).
+					Return(nil)
+			},
+			request: request{
+				method: http.MethodPost,
+				path:   "/api/user/urls/a1B2c3D/restore",
+			},
+			expected: expected{
+				code: http.StatusOK,
+				body: `{"short_url":"` + s.config.BaseURL + `/a1B2c3D","original_url":"https://lengthy-url.com/",` +
+					`"display_url":"https://lengthy-url.com/"}`,
+				contentType: "application/json",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.prepareMocks(s.svcMock)
+
+			resp, body := s.testRequest(
+				tc.request.method, tc.request.path, "", "")
+			defer resp.Body.Close()
+
+			s.Assert().Equal(tc.expected.code, resp.StatusCode)
+			s.Assert().Equal(tc.expected.body, body)
+			s.Assert().Equal(tc.expected.contentType, resp.Header.Get("Content-Type"))
+		})
+	}
+}
@@ -3,10 +3,13 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 
 	rest "github.com/vstdy/go-shortener/api/rest/model"
 	"github.com/vstdy/go-shortener/model"
@@ -63,6 +66,39 @@ func (s *TestSuite) TestServer_shortenURL() {
 				contentType: "text/plain; charset=utf-8",
 			},
 		},
+		{
+			name: "Fail: url policy violation",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) model.URL {
+				input := model.URL{
+					UserID: s.userID,
+					URL:    "javascript:alert(1)",
+				}
+
+				ServiceMock.EXPECT().
+					AddURL(gomock.Any(), &input).
+					Return(fmt.Errorf("shortener: AddURL: %w", &pkg.InputError{
+						Field:   "url",
+						Reason:  "scheme_not_allowed",
+						Message: `scheme "javascript" is not allowed`,
+					}))
+
+				return model.URL{}
+			},
+			request: request{
+				method:      http.MethodPost,
+				path:        "/api/shorten",
+				body:        `{"url":"javascript:alert(1)"}`,
+				contentType: "application/json",
+			},
+			expected: expected{
+				code: http.StatusBadRequest,
+				prepareBody: func(obj model.URL) string {
+					return `{"error":"invalid input","field":"url","reason":"scheme_not_allowed",` +
+						`"message":"scheme \"javascript\" is not allowed"}`
+				},
+				contentType: "application/json",
+			},
+		},
 		{
 			name: "Fail: object already exists",
 			prepareMocks: func(ServiceMock *serviceMock.MockService) model.URL {
@@ -75,6 +111,7 @@ func (s *TestSuite) TestServer_shortenURL() {
 					AddURL(gomock.Any(), &input).
 					Do(func(ctx context.Context, obj *model.URL) {
 						obj.ID = 1
+						obj.Code = "a1B2c3D"
 					}).
 					Return(pkg.ErrAlreadyExists)
 
@@ -89,9 +126,9 @@ func (s *TestSuite) TestServer_shortenURL() {
 			expected: expected{
 				code: http.StatusConflict,
 				prepareBody: func(obj model.URL) string {
-					obj.ID = 1
+					obj.Code = "a1B2c3D"
 
-					return s.config.BaseURL + "/" + strconv.Itoa(obj.ID)
+					return s.config.BaseURL + "/" + obj.Code
 				},
 				contentType: "text/plain; charset=utf-8",
 			},
@@ -108,6 +145,7 @@ func (s *TestSuite) TestServer_shortenURL() {
 					AddURL(gomock.Any(), &input).
 					Do(func(ctx context.Context, obj *model.URL) {
 						obj.ID = 1
+						obj.Code = "a1B2c3D"
 					}).
 					Return(nil)
 
@@ -122,13 +160,107 @@ func (s *TestSuite) TestServer_shortenURL() {
 			expected: expected{
 				code: http.StatusCreated,
 				prepareBody: func(obj model.URL) string {
-					obj.ID = 1
+					obj.Code = "a1B2c3D"
+
+					return s.config.BaseURL + "/" + obj.Code
+				},
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "Fail: alias is taken",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) model.URL {
+				input := model.URL{
+					Code:   "spring-sale",
+					UserID: s.userID,
+					URL:    "https://lengthy-url.com/",
+				}
 
-					return s.config.BaseURL + "/" + strconv.Itoa(obj.ID)
+				ServiceMock.EXPECT().
+					AddURL(gomock.Any(), &input).
+					Return(pkg.ErrCodeTaken)
+
+				return input
+			},
+			request: request{
+				method:      http.MethodPost,
+				path:        "/api/shorten",
+				body:        `{"url": "https://lengthy-url.com/", "alias": "spring-sale"}`,
+				contentType: "application/json",
+			},
+			expected: expected{
+				code: http.StatusConflict,
+				prepareBody: func(obj model.URL) string {
+					return "short code is taken\n"
 				},
 				contentType: "text/plain; charset=utf-8",
 			},
 		},
+		{
+			name: "OK: json request body with alias",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) model.URL {
+				input := model.URL{
+					Code:   "spring-sale",
+					UserID: s.userID,
+					URL:    "https://lengthy-url.com/",
+				}
+
+				ServiceMock.EXPECT().
+					AddURL(gomock.Any(), &input).
+					Do(func(ctx context.Context, obj *model.URL) {
+						obj.ID = 1
+					}).
+					Return(nil)
+
+				return input
+			},
+			request: request{
+				method:      http.MethodPost,
+				path:        "/api/shorten",
+				body:        `{"url": "https://lengthy-url.com/", "alias": "spring-sale"}`,
+				contentType: "application/json",
+			},
+			expected: expected{
+				code: http.StatusCreated,
+				prepareBody: func(obj model.URL) string {
+					return `{"result":"` + s.config.BaseURL + `/spring-sale"}`
+				},
+				contentType: "application/json",
+			},
+		},
+		{
+			name: "OK: json request body with ttl",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) model.URL {
+				input := model.URL{
+					UserID: s.userID,
+					URL:    "https://lengthy-url.com/",
+					TTL:    time.Hour,
+				}
+
+				ServiceMock.EXPECT().
+					AddURL(gomock.Any(), &input).
+					Do(func(ctx context.Context, obj *model.URL) {
+						obj.ID = 1
+						obj.Code = "a1B2c3D"
+					}).
+					Return(nil)
+
+				return input
+			},
+			request: request{
+				method:      http.MethodPost,
+				path:        "/api/shorten",
+				body:        `{"url": "https://lengthy-url.com/", "ttl": 3600}`,
+				contentType: "application/json",
+			},
+			expected: expected{
+				code: http.StatusCreated,
+				prepareBody: func(obj model.URL) string {
+					return `{"result":"` + s.config.BaseURL + `/a1B2c3D"}`
+				},
+				contentType: "application/json",
+			},
+		},
 		{
 			name: "OK: json request body",
 			prepareMocks: func(ServiceMock *serviceMock.MockService) model.URL {
@@ -141,6 +273,7 @@ func (s *TestSuite) TestServer_shortenURL() {
 					AddURL(gomock.Any(), &input).
 					Do(func(ctx context.Context, obj *model.URL) {
 						obj.ID = 1
+						obj.Code = "a1B2c3D"
 					}).
 					Return(nil)
 
@@ -156,6 +289,7 @@ func (s *TestSuite) TestServer_shortenURL() {
 				code: http.StatusCreated,
 				prepareBody: func(obj model.URL) string {
 					obj.ID = 1
+					obj.Code = "a1B2c3D"
 
 					urlResp := rest.NewURLRespFromCanon(obj, s.config.BaseURL)
 
@@ -273,6 +407,7 @@ func (s *TestSuite) TestServer_shortenBatchURLs() {
 					Do(func(ctx context.Context, objs *[]model.URL) {
 						for idx := range *objs {
 							(*objs)[idx].ID = idx + 1
+							(*objs)[idx].Code = strconv.Itoa(idx + 1)
 						}
 					}).
 					Return(pkg.ErrAlreadyExists)
@@ -301,6 +436,7 @@ func (s *TestSuite) TestServer_shortenBatchURLs() {
 				prepareBody: func(objs []model.URL) string {
 					for idx := range objs {
 						objs[idx].ID = idx + 1
+						objs[idx].Code = strconv.Itoa(idx + 1)
 					}
 
 					batchRes := rest.NewURLsBatchRespFromCanon(objs, s.config.BaseURL)
@@ -334,6 +470,7 @@ func (s *TestSuite) TestServer_shortenBatchURLs() {
 					Do(func(ctx context.Context, objs *[]model.URL) {
 						for idx := range *objs {
 							(*objs)[idx].ID = idx + 1
+							(*objs)[idx].Code = strconv.Itoa(idx + 1)
 						}
 					}).
 					Return(nil)
@@ -362,6 +499,7 @@ func (s *TestSuite) TestServer_shortenBatchURLs() {
 				prepareBody: func(objs []model.URL) string {
 					for idx := range objs {
 						objs[idx].ID = idx + 1
+						objs[idx].Code = strconv.Itoa(idx + 1)
 					}
 
 					batchRes := rest.NewURLsBatchRespFromCanon(objs, s.config.BaseURL)
@@ -393,8 +531,9 @@ func (s *TestSuite) TestServer_shortenBatchURLs() {
 
 func (s *TestSuite) TestServer_getShortenedURL() {
 	type request struct {
-		method string
-		path   string
+		method  string
+		path    string
+		headers map[string]string
 	}
 
 	type expected struct {
@@ -412,15 +551,15 @@ func (s *TestSuite) TestServer_getShortenedURL() {
 		{
 			name: "Fail: invalid input",
 			prepareMocks: func(ServiceMock *serviceMock.MockService) {
-				input := 1
+				input := "a1B2c3D"
 
 				ServiceMock.EXPECT().
 					GetURL(gomock.Any(), input).
-					Return("", pkg.ErrInvalidInput)
+					Return(model.URL{}, pkg.ErrInvalidInput)
 			},
 			request: request{
 				method: http.MethodGet,
-				path:   "/1",
+				path:   "/a1B2c3D",
 			},
 			expected: expected{
 				code:     http.StatusBadRequest,
@@ -430,15 +569,15 @@ func (s *TestSuite) TestServer_getShortenedURL() {
 		{
 			name: "OK: no content",
 			prepareMocks: func(ServiceMock *serviceMock.MockService) {
-				input := 1
+				input := "a1B2c3D"
 
 				ServiceMock.EXPECT().
 					GetURL(gomock.Any(), input).
-					Return("", nil)
+					Return(model.URL{}, nil)
 			},
 			request: request{
 				method: http.MethodGet,
-				path:   "/1",
+				path:   "/a1B2c3D",
 			},
 			expected: expected{
 				code:     http.StatusGone,
@@ -448,29 +587,71 @@ func (s *TestSuite) TestServer_getShortenedURL() {
 		{
 			name: "OK",
 			prepareMocks: func(ServiceMock *serviceMock.MockService) {
-				input := 1
+				input := "a1B2c3D"
 
 				ServiceMock.EXPECT().
 					GetURL(gomock.Any(), input).
-					Return("https://lengthy-url.com/", nil)
+					Return(model.URL{
+						URL:          "https://lengthy-url.com/",
+						RedirectCode: http.StatusTemporaryRedirect,
+					}, nil)
+
+				ServiceMock.EXPECT().
+					AddClick(gomock.Any(), gomock.Any()).
+					Return(nil)
 			},
 			request: request{
 				method: http.MethodGet,
-				path:   "/1",
+				path:   "/a1B2c3D",
 			},
 			expected: expected{
 				code:     http.StatusTemporaryRedirect,
 				location: "https://lengthy-url.com/",
 			},
 		},
+		{
+			name: "OK: permanent redirect",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				input := "spring-sale"
+
+				ServiceMock.EXPECT().
+					GetURL(gomock.Any(), input).
+					Return(model.URL{
+						ID:           1,
+						URL:          "https://lengthy-url.com/",
+						RedirectCode: http.StatusMovedPermanently,
+					}, nil)
+
+				ServiceMock.EXPECT().
+					AddClick(gomock.Any(), gomock.Any()).
+					Do(func(ctx context.Context, obj model.Click) {
+						s.Assert().Equal(1, obj.URLID)
+						s.Assert().Equal("https://referrer.com/", obj.Referrer)
+						s.Assert().Equal("10.0.0.1", obj.IP)
+					}).
+					Return(nil)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/spring-sale",
+				headers: map[string]string{
+					"Referer":   "https://referrer.com/",
+					"X-Real-IP": "10.0.0.1",
+				},
+			},
+			expected: expected{
+				code:     http.StatusMovedPermanently,
+				location: "https://lengthy-url.com/",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			tc.prepareMocks(s.svcMock)
 
-			resp, _ := s.testRequest(
-				tc.request.method, tc.request.path, "", "")
+			resp, _ := s.testRequestWithHeaders(
+				tc.request.method, tc.request.path, "", tc.request.headers)
 			defer resp.Body.Close()
 
 			s.Assert().Equal(tc.expected.code, resp.StatusCode)
@@ -489,6 +670,7 @@ func (s *TestSuite) TestServer_getUserURLs() {
 		code        int
 		prepareBody func(objs []model.URL) string
 		contentType string
+		nextCursor  string
 	}
 
 	type testCase struct {
@@ -502,8 +684,8 @@ func (s *TestSuite) TestServer_getUserURLs() {
 			name: "OK: no content",
 			prepareMocks: func(ServiceMock *serviceMock.MockService) []model.URL {
 				ServiceMock.EXPECT().
-					GetUsersURLs(gomock.Any(), s.userID).
-					Return(nil, nil)
+					GetUsersURLs(gomock.Any(), s.userID, model.ListParams{}).
+					Return(model.URLsPage{}, nil)
 
 				return nil
 			},
@@ -525,25 +707,27 @@ func (s *TestSuite) TestServer_getUserURLs() {
 				output := []model.URL{
 					{
 						ID:     1,
+						Code:   "a1B2c3D",
 						UserID: s.userID,
 						URL:    "https://lengthy-url-1.com/",
 					},
 					{
 						ID:     2,
+						Code:   "e4F5g6H",
 						UserID: s.userID,
 						URL:    "https://lengthy-url-2.com/",
 					},
 				}
 
 				ServiceMock.EXPECT().
-					GetUsersURLs(gomock.Any(), s.userID).
-					Return(output, nil)
+					GetUsersURLs(gomock.Any(), s.userID, model.ListParams{Cursor: "MQ", Limit: 2}).
+					Return(model.URLsPage{URLs: output, NextCursor: "Mw"}, nil)
 
 				return output
 			},
 			request: request{
 				method: http.MethodGet,
-				path:   "/api/user/urls",
+				path:   "/api/user/urls?limit=2&cursor=MQ",
 			},
 			expected: expected{
 				code: http.StatusOK,
@@ -556,6 +740,93 @@ func (s *TestSuite) TestServer_getUserURLs() {
 					return string(res)
 				},
 				contentType: "application/json",
+				nextCursor:  "Mw",
+			},
+		},
+		{
+			name: "Fail: malformed limit",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) []model.URL {
+				return nil
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls?limit=ten",
+			},
+			expected: expected{
+				code: http.StatusBadRequest,
+				prepareBody: func(objs []model.URL) string {
+					return "limit: strconv.Atoi: parsing \"ten\": invalid syntax\n"
+				},
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "OK: filtered and sorted",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) []model.URL {
+				params := model.ListParams{
+					Filter: model.URLsFilter{
+						Search:      "sale",
+						Domain:      "example.com",
+						CreatedFrom: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Sort: model.ListSortCreatedAt,
+					Desc: true,
+				}
+
+				ServiceMock.EXPECT().
+					GetUsersURLs(gomock.Any(), s.userID, params).
+					Return(model.URLsPage{}, nil)
+
+				return nil
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls?search=sale&domain=example.com&created_from=2022-03-01T00:00:00Z&sort=created_at&order=desc",
+			},
+			expected: expected{
+				code: http.StatusNoContent,
+				prepareBody: func(objs []model.URL) string {
+					return ""
+				},
+				contentType: "",
+			},
+		},
+		{
+			name: "Fail: unsupported order",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) []model.URL {
+				return nil
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls?order=random",
+			},
+			expected: expected{
+				code: http.StatusBadRequest,
+				prepareBody: func(objs []model.URL) string {
+					return "order: unsupported value \"random\"\n"
+				},
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "Fail: malformed cursor",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) []model.URL {
+				ServiceMock.EXPECT().
+					GetUsersURLs(gomock.Any(), s.userID, model.ListParams{Cursor: "bad"}).
+					Return(model.URLsPage{}, fmt.Errorf("%w: cursor: malformed", pkg.ErrInvalidInput))
+
+				return nil
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls?cursor=bad",
+			},
+			expected: expected{
+				code: http.StatusBadRequest,
+				prepareBody: func(objs []model.URL) string {
+					return "invalid input: cursor: malformed\n"
+				},
+				contentType: "text/plain; charset=utf-8",
 			},
 		},
 	}
@@ -570,11 +841,132 @@ func (s *TestSuite) TestServer_getUserURLs() {
 
 			s.Assert().Equal(tc.expected.code, resp.StatusCode)
 			s.Assert().Equal(tc.expected.contentType, resp.Header.Get("Content-Type"))
+			s.Assert().Equal(tc.expected.nextCursor, resp.Header.Get("X-Next-Cursor"))
 			s.Assert().Equal(tc.expected.prepareBody(output), body)
 		})
 	}
 }
 
+func (s *TestSuite) TestServer_updateURL() {
+	type request struct {
+		method      string
+		path        string
+		body        string
+		contentType string
+	}
+
+	type expected struct {
+		code        int
+		body        string
+		contentType string
+	}
+
+	type testCase struct {
+		name         string
+		prepareMocks func(ServiceMock *serviceMock.MockService)
+		request      request
+		expected     expected
+	}
+	testCases := []testCase{
+		{
+			name: "Fail: url not found",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: s.userID,
+					URL:    "https://lengthy-url.com/",
+				}
+
+				ServiceMock.EXPECT().
+					UpdateURL(gomock.Any(), &input).
+					Return(pkg.ErrNotFound)
+			},
+			request: request{
+				method:      http.MethodPatch,
+				path:        "/api/user/urls/a1B2c3D",
+				body:        `{"url": "https://lengthy-url.com/"}`,
+				contentType: "application/json",
+			},
+			expected: expected{
+				code:        http.StatusNotFound,
+				body:        "object not found\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "Fail: url is already shortened",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: s.userID,
+					URL:    "https://lengthy-url.com/",
+				}
+
+				ServiceMock.EXPECT().
+					UpdateURL(gomock.Any(), &input).
+					Return(pkg.ErrAlreadyExists)
+			},
+			request: request{
+				method:      http.MethodPatch,
+				path:        "/api/user/urls/a1B2c3D",
+				body:        `{"url": "https://lengthy-url.com/"}`,
+				contentType: "application/json",
+			},
+			expected: expected{
+				code:        http.StatusConflict,
+				body:        "object exists in the DB\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "OK",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				input := model.URL{
+					Code:         "a1B2c3D",
+					UserID:       s.userID,
+					URL:          "HTTPS://Lengthy-URL.com/",
+					RedirectCode: http.StatusMovedPermanently,
+				}
+
+				ServiceMock.EXPECT().
+					UpdateURL(gomock.Any(), &input).
+					Do(func(ctx context.Context, obj *model.URL) {
+						obj.ID = 1
+						obj.InputURL = obj.URL
+						obj.URL = "https://lengthy-url.com/"
+					}).
+					Return(nil)
+			},
+			request: request{
+				method:      http.MethodPatch,
+				path:        "/api/user/urls/a1B2c3D",
+				body:        `{"url": "HTTPS://Lengthy-URL.com/", "redirect_code": 301}`,
+				contentType: "application/json",
+			},
+			expected: expected{
+				code: http.StatusOK,
+				body: `{"short_url":"` + s.config.BaseURL + `/a1B2c3D","original_url":"https://lengthy-url.com/",` +
+					`"display_url":"HTTPS://Lengthy-URL.com/"}`,
+				contentType: "application/json",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.prepareMocks(s.svcMock)
+
+			resp, body := s.testRequest(
+				tc.request.method, tc.request.path, tc.request.body, tc.request.contentType)
+			defer resp.Body.Close()
+
+			s.Assert().Equal(tc.expected.code, resp.StatusCode)
+			s.Assert().Equal(tc.expected.body, body)
+			s.Assert().Equal(tc.expected.contentType, resp.Header.Get("Content-Type"))
+		})
+	}
+}
+
 func (s *TestSuite) TestServer_deleteUserURLs() {
 	type request struct {
 		method      string
@@ -587,6 +979,7 @@ func (s *TestSuite) TestServer_deleteUserURLs() {
 		code        int
 		body        string
 		contentType string
+		location    string
 	}
 
 	type testCase struct {
@@ -601,7 +994,7 @@ func (s *TestSuite) TestServer_deleteUserURLs() {
 			prepareMocks: func(ServiceMock *serviceMock.MockService) {
 				ServiceMock.EXPECT().
 					RemoveUsersURLs(gomock.Any(), nil).
-					Return(pkg.ErrInvalidInput)
+					Return(uuid.Nil, pkg.ErrInvalidInput)
 			},
 			request: request{
 				method:      http.MethodDelete,
@@ -620,29 +1013,30 @@ func (s *TestSuite) TestServer_deleteUserURLs() {
 			prepareMocks: func(ServiceMock *serviceMock.MockService) {
 				input := []model.URL{
 					{
-						ID:     1,
+						Code:   "a1B2c3D",
 						UserID: s.userID,
 					},
 					{
-						ID:     2,
+						Code:   "1",
 						UserID: s.userID,
 					},
 				}
 
 				ServiceMock.EXPECT().
 					RemoveUsersURLs(gomock.Any(), input).
-					Return(nil)
+					Return(uuid.MustParse("5f2b6c7e-0d3a-4b8e-9a61-2f4c8d1e7b90"), nil)
 			},
 			request: request{
 				method:      http.MethodDelete,
 				path:        "/api/user/urls",
-				body:        `["1","2"]`,
+				body:        `["a1B2c3D","1"]`,
 				contentType: "application/json",
 			},
 			expected: expected{
 				code:        http.StatusAccepted,
-				body:        "",
-				contentType: "",
+				body:        `{"job":"5f2b6c7e-0d3a-4b8e-9a61-2f4c8d1e7b90"}`,
+				contentType: "application/json",
+				location:    "/api/user/urls/deletions/5f2b6c7e-0d3a-4b8e-9a61-2f4c8d1e7b90",
 			},
 		},
 	}
@@ -655,6 +1049,131 @@ func (s *TestSuite) TestServer_deleteUserURLs() {
 				tc.request.method, tc.request.path, tc.request.body, tc.request.contentType)
 			defer resp.Body.Close()
 
+			s.Assert().Equal(tc.expected.code, resp.StatusCode)
+			s.Assert().Equal(tc.expected.contentType, resp.Header.Get("Content-Type"))
+			s.Assert().Equal(tc.expected.location, resp.Header.Get("Location"))
+			s.Assert().Equal(tc.expected.body, body)
+		})
+	}
+}
+
+func (s *TestSuite) TestServer_getDeletionJob() {
+	type request struct {
+		method string
+		path   string
+	}
+
+	type expected struct {
+		code        int
+		body        string
+		contentType string
+	}
+
+	type testCase struct {
+		name         string
+		prepareMocks func(ServiceMock *serviceMock.MockService)
+		request      request
+		expected     expected
+	}
+
+	jobID := uuid.MustParse("5f2b6c7e-0d3a-4b8e-9a61-2f4c8d1e7b90")
+	createdAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	testCases := []testCase{
+		{
+			name:         "Fail: invalid job id",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/deletions/1",
+			},
+			expected: expected{
+				code:        http.StatusBadRequest,
+				body:        "job: invalid UUID length: 1\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "Fail: not found",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				ServiceMock.EXPECT().
+					GetDeletionJob(gomock.Any(), s.userID, jobID).
+					Return(model.DeletionJob{}, pkg.ErrNotFound)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/deletions/" + jobID.String(),
+			},
+			expected: expected{
+				code:        http.StatusNotFound,
+				body:        "object not found\n",
+				contentType: "text/plain; charset=utf-8",
+			},
+		},
+		{
+			name: "OK: pending",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				ServiceMock.EXPECT().
+					GetDeletionJob(gomock.Any(), s.userID, jobID).
+					Return(model.DeletionJob{
+						ID:        jobID,
+						UserID:    s.userID,
+						CreatedAt: createdAt,
+						Deletions: []model.Deletion{
+							{Code: "a1B2c3D", Status: model.DeletionDeleted},
+							{Code: "e4F5g6H", Status: model.DeletionPending},
+						},
+					}, nil)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/deletions/" + jobID.String(),
+			},
+			expected: expected{
+				code: http.StatusOK,
+				body: `{"job":"5f2b6c7e-0d3a-4b8e-9a61-2f4c8d1e7b90","status":"pending",` +
+					`"created_at":"2026-10-18T12:00:00Z","urls":[{"id":"a1B2c3D","status":"deleted"},` +
+					`{"id":"e4F5g6H","status":"pending"}]}`,
+				contentType: "application/json",
+			},
+		},
+		{
+			name: "OK: done",
+			prepareMocks: func(ServiceMock *serviceMock.MockService) {
+				ServiceMock.EXPECT().
+					GetDeletionJob(gomock.Any(), s.userID, jobID).
+					Return(model.DeletionJob{
+						ID:        jobID,
+						UserID:    s.userID,
+						CreatedAt: createdAt,
+						Deletions: []model.Deletion{
+							{Code: "a1B2c3D", Status: model.DeletionNotFound},
+							{Code: "e4F5g6H", Status: model.DeletionNotOwned},
+							{Code: "i7J8k9L", Status: model.DeletionPending, DeadAt: createdAt},
+						},
+					}, nil)
+			},
+			request: request{
+				method: http.MethodGet,
+				path:   "/api/user/urls/deletions/" + jobID.String(),
+			},
+			expected: expected{
+				code: http.StatusOK,
+				body: `{"job":"5f2b6c7e-0d3a-4b8e-9a61-2f4c8d1e7b90","status":"done",` +
+					`"created_at":"2026-10-18T12:00:00Z","urls":[{"id":"a1B2c3D","status":"not_found"},` +
+					`{"id":"e4F5g6H","status":"not_owned"},{"id":"i7J8k9L","status":"failed"}]}`,
+				contentType: "application/json",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.prepareMocks(s.svcMock)
+
+			resp, body := s.testRequest(tc.request.method, tc.request.path, "", "")
+			defer resp.Body.Close()
+
 			s.Assert().Equal(tc.expected.code, resp.StatusCode)
 			s.Assert().Equal(tc.expected.contentType, resp.Header.Get("Content-Type"))
 			s.Assert().Equal(tc.expected.body, body)
@@ -14,12 +14,21 @@ import (
 	apiGrpc "github.com/vstdy/go-shortener/api/grpc"
 	"github.com/vstdy/go-shortener/api/grpc/model"
 	"github.com/vstdy/go-shortener/cmd/shortener/cmd/common"
+	canonical "github.com/vstdy/go-shortener/model"
 	"github.com/vstdy/go-shortener/pkg/grpc/url-service"
 	"github.com/vstdy/go-shortener/pkg/logging"
 )
 
 const (
-	flagToken = "token"
+	flagToken  = "token"
+	flagAlias  = "alias"
+	flagBucket = "bucket"
+	flagLimit  = "limit"
+	flagCursor = "cursor"
+	flagSearch = "search"
+	flagDomain = "domain"
+	flagSort   = "sort"
+	flagDesc   = "desc"
 )
 
 // newClientCmd creates a new gRPC-client command.
@@ -34,6 +43,7 @@ func newClientCmd() *cobra.Command {
 	cmd.AddCommand(shortenURLCmd())
 	cmd.AddCommand(newGetCmd())
 	cmd.AddCommand(deleteUserURLsCmd())
+	cmd.AddCommand(restoreURLCmd())
 
 	return cmd
 }
@@ -47,6 +57,9 @@ func newGetCmd() *cobra.Command {
 
 	cmd.AddCommand(getOriginalURLCmd())
 	cmd.AddCommand(getUsersURLsCmd())
+	cmd.AddCommand(getURLStatsCmd())
+	cmd.AddCommand(getUsersDeletedURLsCmd())
+	cmd.AddCommand(getDeletionJobCmd())
 
 	return cmd
 }
@@ -76,13 +89,18 @@ func shortenURLCmd() *cobra.Command {
 				return err
 			}
 
+			alias, err := cmd.Flags().GetString(flagAlias)
+			if err != nil {
+				return fmt.Errorf("parsing '%s' flag: %v", flagAlias, err)
+			}
+
 			ctx, cancel := context.WithTimeout(ctx, config.Timeout)
 			defer cancel()
 
 			var header metadata.MD
 			resp, err := client.ShortenURL(
 				ctx,
-				model.NewShortenURLReq(args[0]),
+				model.NewShortenURLReq(args[0], alias),
 				grpc.Header(&header),
 			)
 			if err != nil {
@@ -95,6 +113,8 @@ func shortenURLCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringP(flagAlias, "a", "", "Custom short code")
+
 	cmd.AddCommand(shortenURLsBatchCmd())
 
 	return cmd
@@ -201,12 +221,50 @@ func getUsersURLsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "users_urls",
 		Short:   "Get user's URLs",
-		Example: "get users_urls",
+		Example: "get users_urls --limit 10 --domain example.com --sort created_at --desc",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config := common.GetConfigFromCmdCtx(cmd)
 			logger := logging.NewLogger(logging.WithLogLevel(config.LogLevel))
 			ctx := logging.SetCtxLogger(context.Background(), logger)
 
+			limit, err := cmd.Flags().GetInt(flagLimit)
+			if err != nil {
+				return fmt.Errorf("parsing '%s' flag: %v", flagLimit, err)
+			}
+
+			cursor, err := cmd.Flags().GetString(flagCursor)
+			if err != nil {
+				return fmt.Errorf("parsing '%s' flag: %v", flagCursor, err)
+			}
+
+			search, err := cmd.Flags().GetString(flagSearch)
+			if err != nil {
+				return fmt.Errorf("parsing '%s' flag: %v", flagSearch, err)
+			}
+
+			domain, err := cmd.Flags().GetString(flagDomain)
+			if err != nil {
+				return fmt.Errorf("parsing '%s' flag: %v", flagDomain, err)
+			}
+
+			sort, err := cmd.Flags().GetString(flagSort)
+			if err != nil {
+				return fmt.Errorf("parsing '%s' flag: %v", flagSort, err)
+			}
+
+			desc, err := cmd.Flags().GetBool(flagDesc)
+			if err != nil {
+				return fmt.Errorf("parsing '%s' flag: %v", flagDesc, err)
+			}
+
+			params := canonical.ListParams{
+				Cursor: cursor,
+				Limit:  limit,
+				Filter: canonical.URLsFilter{Search: search, Domain: domain},
+				Sort:   canonical.ListSort(sort),
+				Desc:   desc,
+			}
+
 			conn, err := createGRPCClientConnection(config.GRPCServer.ServerAddress, logger)
 			if err != nil {
 				return err
@@ -225,6 +283,115 @@ func getUsersURLsCmd() *cobra.Command {
 
 			var header metadata.MD
 			resp, err := client.GetUsersURLs(
+				ctx,
+				model.NewGetUsersURLsReq(params),
+				grpc.Header(&header),
+			)
+			if err != nil {
+				return fmt.Errorf("request failed: %v", err)
+			}
+
+			logger.Info().Msgf("%s\nnext cursor %s\ntoken %s",
+				resp.GetResponse(), resp.GetNextCursor(), header[apiGrpc.HeaderAuthorize][0],
+			)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntP(flagLimit, "l", 0, "Page size")
+	cmd.Flags().StringP(flagCursor, "c", "", "Cursor of the page")
+	cmd.Flags().StringP(flagSearch, "s", "", "Substring of the URL")
+	cmd.Flags().StringP(flagDomain, "d", "", "Domain of the URL")
+	cmd.Flags().String(flagSort, "", "Sort field [id, created_at]")
+	cmd.Flags().Bool(flagDesc, false, "Sort in descending order")
+
+	return cmd
+}
+
+// getURLStatsCmd returns a gRPC-client command for GetURLStats request.
+func getURLStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "url_stats",
+		Short:   "Get user's URL clicks statistics",
+		Example: "get url_stats {id}",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			logger := logging.NewLogger(logging.WithLogLevel(config.LogLevel))
+			ctx := logging.SetCtxLogger(context.Background(), logger)
+
+			bucket, err := cmd.Flags().GetString(flagBucket)
+			if err != nil {
+				return fmt.Errorf("parsing '%s' flag: %v", flagBucket, err)
+			}
+
+			conn, err := createGRPCClientConnection(config.GRPCServer.ServerAddress, logger)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := urlService.NewURLServiceClient(conn)
+
+			ctx, err = parseTokenFlag(cmd, ctx)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, config.Timeout)
+			defer cancel()
+
+			var header metadata.MD
+			resp, err := client.GetURLStats(
+				ctx,
+				model.NewGetURLStatsReq(args[0], bucket),
+				grpc.Header(&header),
+			)
+			if err != nil {
+				return fmt.Errorf("request failed: %v", err)
+			}
+
+			logger.Info().Msgf("%s\ntoken %s", resp, header[apiGrpc.HeaderAuthorize][0])
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flagBucket, "b", "day", "Stats bucket [hour, day]")
+
+	return cmd
+}
+
+// getUsersDeletedURLsCmd returns a gRPC-client command for GetUsersDeletedURLs request.
+func getUsersDeletedURLsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deleted_urls",
+		Short:   "Get user's deleted URLs",
+		Example: "get deleted_urls",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			logger := logging.NewLogger(logging.WithLogLevel(config.LogLevel))
+			ctx := logging.SetCtxLogger(context.Background(), logger)
+
+			conn, err := createGRPCClientConnection(config.GRPCServer.ServerAddress, logger)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := urlService.NewURLServiceClient(conn)
+
+			ctx, err = parseTokenFlag(cmd, ctx)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, config.Timeout)
+			defer cancel()
+
+			var header metadata.MD
+			resp, err := client.GetUsersDeletedURLs(
 				ctx,
 				&emptypb.Empty{},
 				grpc.Header(&header),
@@ -242,6 +409,53 @@ func getUsersURLsCmd() *cobra.Command {
 	return cmd
 }
 
+// restoreURLCmd returns a gRPC-client command for RestoreURL request.
+func restoreURLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "restore",
+		Short:   "Restore given user's deleted URL",
+		Example: "restore {id}",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			logger := logging.NewLogger(logging.WithLogLevel(config.LogLevel))
+			ctx := logging.SetCtxLogger(context.Background(), logger)
+
+			conn, err := createGRPCClientConnection(config.GRPCServer.ServerAddress, logger)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := urlService.NewURLServiceClient(conn)
+
+			ctx, err = parseTokenFlag(cmd, ctx)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, config.Timeout)
+			defer cancel()
+
+			var header metadata.MD
+			resp, err := client.RestoreURL(
+				ctx,
+				model.NewRestoreURLReq(args[0]),
+				grpc.Header(&header),
+			)
+			if err != nil {
+				return fmt.Errorf("request failed: %v", err)
+			}
+
+			logger.Info().Msgf("%s\ntoken %s", resp, header[apiGrpc.HeaderAuthorize][0])
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 // deleteUserURLsCmd returns a gRPC-client command for DeleteUserURLs request.
 func deleteUserURLsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -271,7 +485,7 @@ func deleteUserURLsCmd() *cobra.Command {
 			defer cancel()
 
 			var header metadata.MD
-			_, err = client.DeleteUserURLs(
+			resp, err := client.DeleteUserURLs(
 				ctx,
 				model.NewDelUserURLsReq(args),
 				grpc.Header(&header),
@@ -280,7 +494,54 @@ func deleteUserURLsCmd() *cobra.Command {
 				return fmt.Errorf("request failed: %v", err)
 			}
 
-			logger.Info().Msgf("%s\ntoken %s", "ok", header[apiGrpc.HeaderAuthorize][0])
+			logger.Info().Msgf("%s\ntoken %s", resp, header[apiGrpc.HeaderAuthorize][0])
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// getDeletionJobCmd returns a gRPC-client command for GetDeletionJob request.
+func getDeletionJobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deletion",
+		Short:   "Get user's URLs deletion job outcomes",
+		Example: "get deletion {job}",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			logger := logging.NewLogger(logging.WithLogLevel(config.LogLevel))
+			ctx := logging.SetCtxLogger(context.Background(), logger)
+
+			conn, err := createGRPCClientConnection(config.GRPCServer.ServerAddress, logger)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := urlService.NewURLServiceClient(conn)
+
+			ctx, err = parseTokenFlag(cmd, ctx)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, config.Timeout)
+			defer cancel()
+
+			var header metadata.MD
+			resp, err := client.GetDeletionJob(
+				ctx,
+				model.NewGetDeletionJobReq(args[0]),
+				grpc.Header(&header),
+			)
+			if err != nil {
+				return fmt.Errorf("request failed: %v", err)
+			}
+
+			logger.Info().Msgf("%s\ntoken %s", resp, header[apiGrpc.HeaderAuthorize][0])
 
 			return nil
 		},
@@ -82,21 +82,23 @@ func (config Config) BuildPsqlStorage() (*psql.Storage, error) {
 	return st, nil
 }
 
-// BuildService builds shortener.Service dependency.
-func (config Config) BuildService() (*shortener.Service, error) {
-	var st storage.Storage
-	var err error
-
-	switch config.StorageType {
+// BuildStorage builds storage.Storage dependency of the given type.
+func (config Config) BuildStorage(storageType string) (storage.Storage, error) {
+	switch storageType {
 	case memoryStorage:
-		st, err = config.BuildMemoryStorage()
+		return config.BuildMemoryStorage()
 	case fileStorage:
-		st, err = config.BuildFileStorage()
+		return config.BuildFileStorage()
 	case psqlStorage:
-		st, err = config.BuildPsqlStorage()
+		return config.BuildPsqlStorage()
 	default:
-		err = pkg.ErrUnsupportedStorageType
+		return nil, pkg.ErrUnsupportedStorageType
 	}
+}
+
+// BuildService builds shortener.Service dependency.
+func (config Config) BuildService() (*shortener.Service, error) {
+	st, err := config.BuildStorage(config.StorageType)
 	if err != nil {
 		return nil, err
 	}
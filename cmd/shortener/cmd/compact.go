@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vstdy/go-shortener/cmd/shortener/cmd/common"
+	"github.com/vstdy/go-shortener/pkg/logging"
+)
+
+// newCompactCmd creates a new compact command.
+func newCompactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compact",
+		Short: "Compact file storage url and clicks logs, the server must be stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			_, logger := logging.GetCtxLogger(context.Background(), logging.WithLogLevel(config.LogLevel))
+
+			st, err := config.BuildFileStorage()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err = st.Close(); err != nil {
+					logger.Error().Err(err).Msg("Shutting down the app")
+				}
+			}()
+
+			if err = st.Compact(); err != nil {
+				return err
+			}
+			if err = st.CompactClicks(); err != nil {
+				return err
+			}
+			logger.Info().Msg("File storage compacted")
+
+			return nil
+		},
+	}
+
+	config := common.BuildDefaultConfig()
+	cmd.Flags().StringP(flagFileStoragePath, "f", config.FileStorage.FileStoragePath, "File storage path")
+
+	return cmd
+}
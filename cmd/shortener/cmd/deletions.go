@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vstdy/go-shortener/cmd/shortener/cmd/common"
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg/logging"
+	inter "github.com/vstdy/go-shortener/storage"
+)
+
+const deadDeletionsBatchSize = 1000
+
+// newDeletionsCmd creates a new deletions command.
+func newDeletionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deletions",
+		Short: "Inspect deletions out of attempts (dead letters)",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newDeletionsDeadCmd())
+	cmd.AddCommand(newDeletionsRequeueCmd())
+
+	return cmd
+}
+
+// newDeletionsDeadCmd creates a new deletions dead command.
+func newDeletionsDeadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dead",
+		Short: "List deletions moved to dead letters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithStorage(cmd, func(ctx context.Context, st inter.Storage) error {
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "ID\tUSER\tCODE\tATTEMPTS\tDEAD AT\tLAST ERROR")
+
+				cnt := 0
+				err := forEachDeadDeletion(ctx, st, func(dels []model.Deletion) error {
+					for _, del := range dels {
+						fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\n",
+							del.ID, del.UserID, del.Code, del.Attempts, del.DeadAt.Format(time.RFC3339), del.LastError)
+					}
+					cnt += len(dels)
+
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "\ndead: %d\n", cnt)
+
+				return w.Flush()
+			})
+		},
+	}
+
+	addDeletionsStorageFlags(cmd)
+
+	return cmd
+}
+
+// newDeletionsRequeueCmd creates a new deletions requeue command.
+func newDeletionsRequeueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requeue [id...]",
+		Short: "Move deletions with given ids or all of them from dead letters back to the queue",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := make(map[int]bool, len(args))
+			for _, arg := range args {
+				id, err := strconv.Atoi(arg)
+				if err != nil {
+					return fmt.Errorf("parsing deletion id %q: %w", arg, err)
+				}
+				ids[id] = true
+			}
+
+			return runWithStorage(cmd, func(ctx context.Context, st inter.Storage) error {
+				_, logger := logging.GetCtxLogger(ctx)
+
+				now := time.Now()
+				cnt := 0
+				err := forEachDeadDeletion(ctx, st, func(dels []model.Deletion) error {
+					var requeued []model.Deletion
+					for _, del := range dels {
+						if len(ids) > 0 && !ids[del.ID] {
+							continue
+						}
+
+						del.Attempts = 0
+						del.NextAttemptAt = now
+						del.DeadAt = time.Time{}
+						requeued = append(requeued, del)
+					}
+					if len(requeued) == 0 {
+						return nil
+					}
+
+					if err := st.UpdateDeletions(ctx, requeued); err != nil {
+						return err
+					}
+					cnt += len(requeued)
+
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				logger.Info().Int("deletions", cnt).Msg("Dead deletions requeued")
+
+				return nil
+			})
+		},
+	}
+
+	addDeletionsStorageFlags(cmd)
+
+	return cmd
+}
+
+// addDeletionsStorageFlags adds storage flags to a deletions subcommand.
+func addDeletionsStorageFlags(cmd *cobra.Command) {
+	config := common.BuildDefaultConfig()
+	cmd.Flags().StringP(flagStorageType, "s", config.StorageType, "Storage type [file, psql]")
+	cmd.Flags().StringP(flagFileStoragePath, "f", config.FileStorage.FileStoragePath, "File storage path")
+}
+
+// runWithStorage builds configured storage and runs the storage operation within request timeout.
+func runWithStorage(cmd *cobra.Command, run func(ctx context.Context, st inter.Storage) error) error {
+	config := common.GetConfigFromCmdCtx(cmd)
+	ctx, logger := logging.GetCtxLogger(context.Background(), logging.WithLogLevel(config.LogLevel))
+
+	st, err := config.BuildStorage(config.StorageType)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err = st.Close(); err != nil {
+			logger.Error().Err(err).Msg("Shutting down the app")
+		}
+	}()
+
+	ctx, ctxCancel := context.WithTimeout(ctx, config.Timeout)
+	defer ctxCancel()
+
+	return run(ctx, st)
+}
+
+// forEachDeadDeletion passes dead deletions to given function in batches ordered by id.
+func forEachDeadDeletion(ctx context.Context, st inter.Storage, fn func(dels []model.Deletion) error) error {
+	afterID := 0
+	for {
+		dels, err := st.GetDeadDeletions(ctx, afterID, deadDeletionsBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(dels) == 0 {
+			return nil
+		}
+
+		if err = fn(dels); err != nil {
+			return err
+		}
+		afterID = dels[len(dels)-1].ID
+	}
+}
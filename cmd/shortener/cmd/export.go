@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vstdy/go-shortener/cmd/shortener/cmd/common"
+	"github.com/vstdy/go-shortener/pkg/logging"
+	"github.com/vstdy/go-shortener/storage/transfer"
+)
+
+const (
+	flagFormat = "format"
+	flagOutput = "output"
+	flagInput  = "input"
+)
+
+// newExportCmd creates a new export command.
+func newExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export urls of the storage to JSON lines or CSV",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			ctx, logger := logging.GetCtxLogger(context.Background(), logging.WithLogLevel(config.LogLevel))
+
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if err = transfer.Format(format).Validate(); err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(flagBatchSize)
+			if err != nil {
+				return err
+			}
+
+			st, err := config.BuildStorage(config.StorageType)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err = st.Close(); err != nil {
+					logger.Error().Err(err).Msg("Shutting down the app")
+				}
+			}()
+
+			var w io.Writer = os.Stdout
+			if output != "" {
+				file, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				w = file
+			}
+
+			count, err := transfer.Export(ctx, st, w, transfer.Format(format), batchSize)
+			if err != nil {
+				return err
+			}
+			logger.Info().Int("urls", count).Msg("Urls exported")
+
+			return nil
+		},
+	}
+
+	config := common.BuildDefaultConfig()
+	cmd.Flags().String(flagFormat, string(transfer.FormatJSONL), "Data format [jsonl, csv]")
+	cmd.Flags().StringP(flagOutput, "o", "", "Output file path, stdout by default")
+	cmd.Flags().Int(flagBatchSize, 1000, "Number of objects read at once")
+	cmd.Flags().StringP(flagStorageType, "s", config.StorageType, "Storage type [memory, file, psql]")
+	cmd.Flags().StringP(flagFileStoragePath, "f", config.FileStorage.FileStoragePath, "File storage path")
+
+	return cmd
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vstdy/go-shortener/cmd/shortener/cmd/common"
+	"github.com/vstdy/go-shortener/pkg/logging"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
+	"github.com/vstdy/go-shortener/storage/transfer"
+)
+
+const flagReassignIDs = "reassign_ids"
+
+// newImportCmd creates a new import command.
+func newImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import urls from JSON lines or CSV to the storage, the server must be stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			ctx, logger := logging.GetCtxLogger(context.Background(), logging.WithLogLevel(config.LogLevel))
+
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if err = transfer.Format(format).Validate(); err != nil {
+				return err
+			}
+			input, err := cmd.Flags().GetString(flagInput)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(flagBatchSize)
+			if err != nil {
+				return err
+			}
+			reassignIDs, err := cmd.Flags().GetBool(flagReassignIDs)
+			if err != nil {
+				return err
+			}
+
+			codeGen, err := shortcode.NewGenerator(config.Service.CodeAlphabet, config.Service.CodeLength)
+			if err != nil {
+				return fmt.Errorf("short code generator: %w", err)
+			}
+
+			st, err := config.BuildStorage(config.StorageType)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err = st.Close(); err != nil {
+					logger.Error().Err(err).Msg("Shutting down the app")
+				}
+			}()
+
+			var r io.Reader = os.Stdin
+			if input != "" {
+				file, err := os.Open(input)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				r = file
+			}
+
+			importConfig := transfer.ImportConfig{
+				Format:           transfer.Format(format),
+				BatchSize:        batchSize,
+				KeepIDs:          !reassignIDs,
+				DedupScope:       config.Service.DedupScope,
+				CodeGen:          codeGen,
+				URLPolicy:        config.Service.URLPolicy(),
+				URLCanonicalizer: config.Service.URLCanonicalizer(),
+			}
+			report := func(rowErr transfer.RowError) {
+				logger.Warn().Int("row", rowErr.Row).Err(rowErr.Err).Msg("Row skipped")
+			}
+
+			stats, err := transfer.Import(ctx, st, r, importConfig, report)
+			if err != nil {
+				return err
+			}
+			logger.Info().Int("imported", stats.Imported).Int("failed", stats.Failed).Msg("Urls imported")
+
+			if stats.Failed > 0 {
+				return fmt.Errorf("%d rows failed to import", stats.Failed)
+			}
+
+			return nil
+		},
+	}
+
+	config := common.BuildDefaultConfig()
+	cmd.Flags().String(flagFormat, string(transfer.FormatJSONL), "Data format [jsonl, csv]")
+	cmd.Flags().StringP(flagInput, "i", "", "Input file path, stdin by default")
+	cmd.Flags().Int(flagBatchSize, 1000, "Number of objects written at once")
+	cmd.Flags().Bool(flagReassignIDs, false, "Give objects new ids instead of the imported ones")
+	cmd.Flags().StringP(flagStorageType, "s", config.StorageType, "Storage type [memory, file, psql]")
+	cmd.Flags().StringP(flagFileStoragePath, "f", config.FileStorage.FileStoragePath, "File storage path")
+
+	return cmd
+}
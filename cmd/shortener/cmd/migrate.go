@@ -2,11 +2,17 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/vstdy/go-shortener/cmd/shortener/cmd/common"
 	"github.com/vstdy/go-shortener/pkg/logging"
+	"github.com/vstdy/go-shortener/storage/psql"
+	"github.com/vstdy/go-shortener/storage/psql/migrations"
 )
 
 // newMigrateCmd creates a new migrate command.
@@ -14,26 +20,93 @@ func newMigrateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate",
 		Short: "Migrate DB to the latest version",
+		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			config := common.GetConfigFromCmdCtx(cmd)
-			ctx, logger := logging.GetCtxLogger(context.Background(), logging.WithLogLevel(config.LogLevel))
+			return runWithPsqlStorage(cmd, func(ctx context.Context, st *psql.Storage) error {
+				return st.Migrate(ctx)
+			})
+		},
+	}
 
-			st, err := config.BuildPsqlStorage()
-			if err != nil {
-				return err
-			}
-			defer func() {
-				if err = st.Close(); err != nil {
-					logger.Error().Err(err).Msg("Shutting down the app")
+	cmd.AddCommand(newMigrateDownCmd())
+	cmd.AddCommand(newMigrateStatusCmd())
+	cmd.AddCommand(newMigrateCreateCmd())
+
+	return cmd
+}
+
+// newMigrateDownCmd creates a new migrate down command.
+func newMigrateDownCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Roll back the last applied group of DB migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithPsqlStorage(cmd, func(ctx context.Context, st *psql.Storage) error {
+				return st.Rollback(ctx)
+			})
+		},
+	}
+
+	return cmd
+}
+
+// newMigrateStatusCmd creates a new migrate status command.
+func newMigrateStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show applied and pending DB migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithPsqlStorage(cmd, func(ctx context.Context, st *psql.Storage) error {
+				statuses, err := st.MigrationsStatus(ctx)
+				if err != nil {
+					return err
 				}
-			}()
 
-			ctx, ctxCancel := context.WithTimeout(ctx, config.Timeout)
-			defer ctxCancel()
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "MIGRATION\tGROUP\tMIGRATED AT")
+
+				applied, lastGroupID := 0, int64(0)
+				for _, status := range statuses {
+					if status.GroupID == 0 {
+						fmt.Fprintf(w, "%s\t-\tpending\n", status.Name)
+						continue
+					}
 
-			if err = st.Migrate(ctx); err != nil {
+					applied++
+					if status.GroupID > lastGroupID {
+						lastGroupID = status.GroupID
+					}
+					fmt.Fprintf(w, "%s\t#%d\t%s\n", status.Name, status.GroupID, status.MigratedAt.Format(time.RFC3339))
+				}
+				fmt.Fprintf(w, "\napplied: %d, pending: %d, last group: #%d\n", applied, len(statuses)-applied, lastGroupID)
+
+				return w.Flush()
+			})
+		},
+	}
+
+	return cmd
+}
+
+// newMigrateCreateCmd creates a new migrate create command.
+func newMigrateCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create empty up and down DB migration files",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			_, logger := logging.GetCtxLogger(context.Background(), logging.WithLogLevel(config.LogLevel))
+
+			paths, err := migrations.Create(args[0])
+			if err != nil {
 				return err
 			}
+			for _, path := range paths {
+				logger.Info().Msgf("Migration file created: %s", path)
+			}
 
 			return nil
 		},
@@ -41,3 +114,24 @@ func newMigrateCmd() *cobra.Command {
 
 	return cmd
 }
+
+// runWithPsqlStorage builds psql.Storage and runs the DB operation within request timeout.
+func runWithPsqlStorage(cmd *cobra.Command, run func(ctx context.Context, st *psql.Storage) error) error {
+	config := common.GetConfigFromCmdCtx(cmd)
+	ctx, logger := logging.GetCtxLogger(context.Background(), logging.WithLogLevel(config.LogLevel))
+
+	st, err := config.BuildPsqlStorage()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err = st.Close(); err != nil {
+			logger.Error().Err(err).Msg("Shutting down the app")
+		}
+	}()
+
+	ctx, ctxCancel := context.WithTimeout(ctx, config.Timeout)
+	defer ctxCancel()
+
+	return run(ctx, st)
+}
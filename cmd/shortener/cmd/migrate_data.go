@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vstdy/go-shortener/cmd/shortener/cmd/common"
+	"github.com/vstdy/go-shortener/pkg/logging"
+	"github.com/vstdy/go-shortener/storage/transfer"
+)
+
+const (
+	flagFrom       = "from"
+	flagTo         = "to"
+	flagDryRun     = "dry_run"
+	flagBatchSize  = "batch_size"
+	flagCheckpoint = "checkpoint"
+	flagResume     = "resume"
+)
+
+// newMigrateDataCmd creates a new migrate-data command.
+func newMigrateDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-data",
+		Short: "Move data between storages keeping ids, the server must be stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := common.GetConfigFromCmdCtx(cmd)
+			ctx, logger := logging.GetCtxLogger(context.Background(), logging.WithLogLevel(config.LogLevel))
+
+			from, err := cmd.Flags().GetString(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetString(flagTo)
+			if err != nil {
+				return err
+			}
+			if from == to {
+				return fmt.Errorf("%s and %s storages must differ", flagFrom, flagTo)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(flagDryRun)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(flagBatchSize)
+			if err != nil {
+				return err
+			}
+			checkpointPath, err := cmd.Flags().GetString(flagCheckpoint)
+			if err != nil {
+				return err
+			}
+			resume, err := cmd.Flags().GetBool(flagResume)
+			if err != nil {
+				return err
+			}
+
+			var pos transfer.Position
+			if resume {
+				if pos, err = transfer.LoadPosition(checkpointPath); err != nil {
+					return fmt.Errorf("loading checkpoint: %w", err)
+				}
+				logger.Info().Int("url_id", pos.URLID).Int("click_id", pos.ClickID).Msg("Resuming data migration")
+			}
+
+			src, err := config.BuildStorage(from)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err = src.Close(); err != nil {
+					logger.Error().Err(err).Msg("Shutting down the app")
+				}
+			}()
+
+			dst, err := config.BuildStorage(to)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err = dst.Close(); err != nil {
+					logger.Error().Err(err).Msg("Shutting down the app")
+				}
+			}()
+
+			ctx, ctxCancel := signal.NotifyContext(ctx, os.Interrupt)
+			defer ctxCancel()
+
+			checkpoint := func(pos transfer.Position) error {
+				logger.Debug().Int("url_id", pos.URLID).Int("click_id", pos.ClickID).Msg("Batch migrated")
+				if dryRun {
+					return nil
+				}
+
+				return transfer.SavePosition(checkpointPath, pos)
+			}
+
+			stats, err := transfer.Run(
+				ctx, src, dst,
+				transfer.Config{BatchSize: batchSize, DryRun: dryRun},
+				pos, checkpoint,
+			)
+			if err != nil {
+				return err
+			}
+			logger.Info().
+				Bool("dry_run", dryRun).
+				Int("urls", stats.URLs).
+				Int("clicks", stats.Clicks).
+				Msg("Data migrated")
+
+			return nil
+		},
+	}
+
+	config := common.BuildDefaultConfig()
+	cmd.Flags().String(flagFrom, "", "Source storage type [memory, file, psql]")
+	cmd.Flags().String(flagTo, "", "Destination storage type [memory, file, psql]")
+	cmd.Flags().Bool(flagDryRun, false, "Read source storage only, nothing is written")
+	cmd.Flags().Int(flagBatchSize, 1000, "Number of objects moved at once")
+	cmd.Flags().String(flagCheckpoint, "./migrate_data.json", "Checkpoint file path")
+	cmd.Flags().Bool(flagResume, false, "Continue after the position saved to checkpoint file")
+	cmd.Flags().StringP(flagFileStoragePath, "f", config.FileStorage.FileStoragePath, "File storage path")
+	_ = cmd.MarkFlagRequired(flagFrom)
+	_ = cmd.MarkFlagRequired(flagTo)
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -96,21 +97,22 @@ func newRootCmd() *cobra.Command {
 			}()
 
 			stop := make(chan os.Signal, 1)
-			signal.Notify(stop, os.Interrupt)
+			signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 			<-stop
 
-			// Stop servers
+			// Stop servers, then drain service queues and close storage
 			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer shutdownCancel()
-			if err = srv.Shutdown(shutdownCtx); err != nil {
-				return fmt.Errorf("server shutdown failed: %w", err)
-			}
+			srvErr := srv.Shutdown(shutdownCtx)
 
 			grpcSrv.GracefulStop()
 
 			if err = svc.Close(); err != nil {
 				return fmt.Errorf("service shutdown failed: %w", err)
 			}
+			if srvErr != nil {
+				return fmt.Errorf("server shutdown failed: %w", srvErr)
+			}
 			logger.Info().Msg("server stopped")
 
 			return nil
@@ -128,6 +130,11 @@ func newRootCmd() *cobra.Command {
 	cmd.Flags().StringP(flagFileStoragePath, "f", config.FileStorage.FileStoragePath, "File storage path")
 
 	cmd.AddCommand(newMigrateCmd())
+	cmd.AddCommand(newMigrateDataCmd())
+	cmd.AddCommand(newExportCmd())
+	cmd.AddCommand(newImportCmd())
+	cmd.AddCommand(newCompactCmd())
+	cmd.AddCommand(newDeletionsCmd())
 	cmd.AddCommand(newClientCmd())
 
 	return cmd
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+)
+
+// Click keeps url click data.
+type Click struct {
+	ID        int
+	URLID     int
+	Referrer  string
+	UserAgent string
+	IP        string
+	CreatedAt time.Time
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeletionStatus defines user url deletion outcome.
+type DeletionStatus string
+
+const (
+	// DeletionPending is a deletion not attempted yet or retried.
+	DeletionPending DeletionStatus = "pending"
+	// DeletionDeleted is a deletion of the user url.
+	DeletionDeleted DeletionStatus = "deleted"
+	// DeletionNotFound is a deletion of a missing url.
+	DeletionNotFound DeletionStatus = "not_found"
+	// DeletionNotOwned is a deletion of a url created by another user.
+	DeletionNotOwned DeletionStatus = "not_owned"
+	// DeletionFailed is a deletion out of attempts.
+	DeletionFailed DeletionStatus = "failed"
+)
+
+type (
+	// Deletion keeps accepted user url deletion data.
+	// Pending deletions are attempted at NextAttemptAt,
+	// dead deletions are out of attempts and aren't retried.
+	Deletion struct {
+		ID            int
+		JobID         uuid.UUID
+		UserID        uuid.UUID
+		Code          string
+		Status        DeletionStatus
+		Attempts      int
+		LastError     string
+		NextAttemptAt time.Time
+		CreatedAt     time.Time
+		DoneAt        time.Time
+		DeadAt        time.Time
+	}
+
+	// DeletionJob keeps user url deletions accepted at once.
+	DeletionJob struct {
+		ID        uuid.UUID
+		UserID    uuid.UUID
+		CreatedAt time.Time
+		Deletions []Deletion
+	}
+)
+
+// IsDead checks whether the deletion is moved to dead letters.
+func (d Deletion) IsDead() bool {
+	return !d.DeadAt.IsZero()
+}
+
+// Outcome returns the deletion status reported to the user, dead deletions are failed.
+func (d Deletion) Outcome() DeletionStatus {
+	if d.IsDead() {
+		return DeletionFailed
+	}
+
+	return d.Status
+}
+
+// IsDone checks whether all job deletions are done or dead.
+func (j DeletionJob) IsDone() bool {
+	for _, deletion := range j.Deletions {
+		if deletion.Outcome() == DeletionPending {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ListSort defines the field user url objects are ordered by.
+type ListSort string
+
+const (
+	// ListSortID orders objects by id.
+	ListSortID ListSort = "id"
+	// ListSortCreatedAt orders objects by creation time, ties are ordered by id.
+	ListSortCreatedAt ListSort = "created_at"
+)
+
+type (
+	// ListParams keeps user url objects list query params.
+	ListParams struct {
+		Cursor string
+		Limit  int
+		Filter URLsFilter
+		Sort   ListSort
+		Desc   bool
+	}
+
+	// URLsFilter keeps user url objects filter, zero fields are not applied.
+	// Search matches a substring of the url case-insensitively,
+	// Domain matches the url host or any of its subdomains,
+	// objects are created in [CreatedFrom, CreatedTo) time range.
+	URLsFilter struct {
+		Search      string
+		Domain      string
+		CreatedFrom time.Time
+		CreatedTo   time.Time
+	}
+
+	// URLsQuery keeps user url objects storage query.
+	// Objects are listed after the object with AfterID id and AfterCreatedAt creation time
+	// in the query order, zero AfterID starts from the beginning.
+	URLsQuery struct {
+		Filter         URLsFilter
+		Sort           ListSort
+		Desc           bool
+		AfterID        int
+		AfterCreatedAt time.Time
+		Limit          int
+	}
+
+	// URLsPage keeps a page of user url objects.
+	// NextCursor is empty for the last page.
+	URLsPage struct {
+		URLs       []URL
+		NextCursor string
+	}
+)
+
+// Match checks the object passes the filter.
+func (f URLsFilter) Match(obj URL) bool {
+	if f.Search != "" && !strings.Contains(strings.ToLower(obj.URL), strings.ToLower(f.Search)) {
+		return false
+	}
+
+	if f.Domain != "" {
+		host := URLHost(obj.URL)
+		domain := strings.ToLower(f.Domain)
+		if host != domain && !strings.HasSuffix(host, "."+domain) {
+			return false
+		}
+	}
+
+	if !f.CreatedFrom.IsZero() && obj.CreatedAt.Before(f.CreatedFrom) {
+		return false
+	}
+
+	if !f.CreatedTo.IsZero() && !obj.CreatedAt.Before(f.CreatedTo) {
+		return false
+	}
+
+	return true
+}
+
+// Less reports whether object a goes before object b in the query order.
+func (q URLsQuery) Less(a, b URL) bool {
+	less := a.ID < b.ID
+	if q.Sort == ListSortCreatedAt && !a.CreatedAt.Equal(b.CreatedAt) {
+		less = a.CreatedAt.Before(b.CreatedAt)
+	}
+
+	if q.Desc {
+		return !less && a.ID != b.ID
+	}
+
+	return less
+}
+
+// IsAfter checks the object goes after the query position.
+func (q URLsQuery) IsAfter(obj URL) bool {
+	if q.AfterID == 0 {
+		return true
+	}
+
+	return q.Less(URL{ID: q.AfterID, CreatedAt: q.AfterCreatedAt}, obj)
+}
+
+// URLHost returns lowercased host of the url, empty for malformed urls.
+func URLHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(u.Hostname())
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+type (
+	// StatsParams keeps url statistics query params.
+	StatsParams struct {
+		From   time.Time
+		To     time.Time
+		Bucket time.Duration
+	}
+
+	// URLStats keeps url clicks statistics.
+	URLStats struct {
+		TotalClicks    int
+		UniqueVisitors int
+		Buckets        []StatsBucket
+		TopReferrers   []StatsCounter
+		TopUserAgents  []StatsCounter
+	}
+
+	// StatsBucket keeps number of clicks made in a time bucket.
+	StatsBucket struct {
+		Start  time.Time
+		Clicks int
+	}
+
+	// StatsCounter keeps number of clicks with given value.
+	StatsCounter struct {
+		Value  string
+		Clicks int
+	}
+)
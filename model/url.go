@@ -1,11 +1,44 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DedupScope defines the scope url duplicates are detected within.
+type DedupScope string
+
+const (
+	// DedupScopeGlobal makes a url shortened once for all users.
+	DedupScopeGlobal DedupScope = "global"
+	// DedupScopeUser makes a url shortened once per user.
+	DedupScopeUser DedupScope = "user"
+)
 
 // URL keeps url data.
 type URL struct {
 	ID            int
+	Code          string
 	CorrelationID string
 	UserID        uuid.UUID
+	DedupUserID   uuid.UUID
 	URL           string
+	InputURL      string
+	ExpiresAt     time.Time
+	TTL           time.Duration
+	MaxClicks     int
+	Clicks        int
+	RedirectCode  int
+	CreatedAt     time.Time
+	DeletedAt     time.Time
+}
+
+// DisplayURL returns url as it was given by the user, canonical url is returned if it's unknown.
+func (u URL) DisplayURL() string {
+	if u.InputURL != "" {
+		return u.InputURL
+	}
+
+	return u.URL
 }
@@ -0,0 +1,152 @@
+package canonical
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// defaultPorts maps schemes to their default ports dropped from canonical urls.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// Canonicalizer converts urls to canonical form used for storage and dedup.
+// StripParams patterns match query keys ignoring case, a trailing "*" matches a key prefix.
+type Canonicalizer struct {
+	SortQuery   bool
+	StripParams []string
+}
+
+// Canonicalize returns canonical form of given url.
+// Scheme and host are lowercased, IDN hosts are converted to punycode, default ports are dropped
+// and tracking query params are stripped. Path, fragment and kept params are left as written.
+func (c Canonicalizer) Canonicalize(urlRaw string) (string, error) {
+	u, err := url.ParseRequestURI(urlRaw)
+	if err != nil {
+		return "", err
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	// opaque urls like mailto: have no host and query to normalize
+	if u.Opaque != "" {
+		return u.String(), nil
+	}
+
+	if u.Host != "" {
+		if u.Host, err = canonicalHost(u.Scheme, u.Hostname(), u.Port()); err != nil {
+			return "", err
+		}
+	}
+
+	u.RawQuery = c.canonicalQuery(u.RawQuery)
+	u.ForceQuery = false
+
+	return u.String(), nil
+}
+
+// canonicalHost returns host lowercased and converted to punycode along with non-default port.
+func canonicalHost(scheme, hostname, port string) (string, error) {
+	if !isASCII(hostname) {
+		asciiHost, err := idna.Lookup.ToASCII(hostname)
+		if err != nil {
+			return "", fmt.Errorf("host: %v", err)
+		}
+		hostname = asciiHost
+	}
+	hostname = strings.ToLower(hostname)
+
+	if port == defaultPorts[scheme] {
+		port = ""
+	}
+
+	if port != "" {
+		return net.JoinHostPort(hostname, port), nil
+	}
+	if strings.Contains(hostname, ":") {
+		return "[" + hostname + "]", nil
+	}
+
+	return hostname, nil
+}
+
+// canonicalQuery strips matching params from raw query and sorts the rest by key if configured.
+// Params keep their original encoding, empty params are dropped.
+func (c Canonicalizer) canonicalQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+
+	type param struct {
+		key string
+		raw string
+	}
+
+	var params []param
+	for _, raw := range strings.Split(rawQuery, "&") {
+		if raw == "" {
+			continue
+		}
+
+		key := raw
+		if idx := strings.IndexByte(raw, '='); idx != -1 {
+			key = raw[:idx]
+		}
+		if unescaped, err := url.QueryUnescape(key); err == nil {
+			key = unescaped
+		}
+
+		if c.stripped(key) {
+			continue
+		}
+		params = append(params, param{key: key, raw: raw})
+	}
+
+	if c.SortQuery {
+		sort.SliceStable(params, func(i, j int) bool { return params[i].key < params[j].key })
+	}
+
+	pairs := make([]string, 0, len(params))
+	for _, p := range params {
+		pairs = append(pairs, p.raw)
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// stripped checks whether the query key matches any of strip patterns.
+func (c Canonicalizer) stripped(key string) bool {
+	key = strings.ToLower(key)
+	for _, pattern := range c.StripParams {
+		pattern = strings.ToLower(pattern)
+		if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+			continue
+		}
+		if key == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isASCII checks whether the string consists of ASCII characters only.
+func isASCII(s string) bool {
+	for idx := 0; idx < len(s); idx++ {
+		if s[idx] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
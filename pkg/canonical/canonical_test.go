@@ -0,0 +1,51 @@
+package canonical
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizer_Canonicalize(t *testing.T) {
+	c := Canonicalizer{StripParams: []string{"utm_*", "fbclid"}}
+
+	testCases := []struct {
+		name      string
+		sortQuery bool
+		url       string
+		expected  string
+	}{
+		{name: "Already canonical", url: "https://example.com/a?b=1#c", expected: "https://example.com/a?b=1#c"},
+		{name: "Scheme and host case", url: "HTTPS://Www.Example.COM/Path", expected: "https://www.example.com/Path"},
+		{name: "Default http port", url: "http://example.com:80/", expected: "http://example.com/"},
+		{name: "Default https port", url: "https://example.com:443/", expected: "https://example.com/"},
+		{name: "Non-default port", url: "http://example.com:443/", expected: "http://example.com:443/"},
+		{name: "IPv6 host", url: "https://[2001:DB8::1]:443/", expected: "https://[2001:db8::1]/"},
+		{name: "IDN host", url: "https://Bücher.example/", expected: "https://xn--bcher-kva.example/"},
+		{name: "Tracking params", url: "https://example.com/?UTM_Source=x&id=1&fbclid=y&utm_medium=z", expected: "https://example.com/?id=1"},
+		{name: "Only tracking params", url: "https://example.com/a?utm_source=x", expected: "https://example.com/a"},
+		{name: "Encoded param kept", url: "https://example.com/?q=a%20b&&x", expected: "https://example.com/?q=a%20b&x"},
+		{name: "Query sorted", sortQuery: true, url: "https://example.com/?b=2&a=1&b=1", expected: "https://example.com/?a=1&b=2&b=1"},
+		{name: "Query unsorted", url: "https://example.com/?b=2&a=1", expected: "https://example.com/?b=2&a=1"},
+		{name: "Opaque url", url: "MAILTO:User@Example.com", expected: "mailto:User@Example.com"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := c
+			c.SortQuery = tc.sortQuery
+
+			canonicalURL, err := c.Canonicalize(tc.url)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, canonicalURL)
+		})
+	}
+}
+
+func TestCanonicalizer_Canonicalize_Invalid(t *testing.T) {
+	for _, url := range []string{"example.com", "https://xn--a.\u0080/"} {
+		_, err := (Canonicalizer{}).Canonicalize(url)
+		assert.Error(t, err, url)
+	}
+}
@@ -1,10 +1,36 @@
 package pkg
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrUnsupportedStorageType = errors.New("unsupported storage type")
 	ErrInvalidInput           = errors.New("invalid input")
 	ErrAlreadyExists          = errors.New("object exists in the DB")
 	ErrNoDBConnection         = errors.New("no DB connection")
+	ErrCodeTaken              = errors.New("short code is taken")
+	ErrNotFound               = errors.New("object not found")
+	ErrForbidden              = errors.New("access denied")
+	ErrStorageLocked          = errors.New("storage is locked by another process")
+	ErrServiceClosed          = errors.New("service is shutting down")
 )
+
+// InputError describes rejected input field with machine-readable reason.
+// It matches ErrInvalidInput.
+type InputError struct {
+	Field   string
+	Reason  string
+	Message string
+}
+
+// Error implements error interface.
+func (e *InputError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", ErrInvalidInput, e.Field, e.Message)
+}
+
+// Unwrap returns ErrInvalidInput.
+func (e *InputError) Unwrap() error {
+	return ErrInvalidInput
+}
@@ -12,7 +12,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -30,7 +32,12 @@ type ShortenURLReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Url          string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Alias        string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	ExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Ttl          *durationpb.Duration   `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxClicks    int32                  `protobuf:"varint,5,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`
+	RedirectCode int32                  `protobuf:"varint,6,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
 }
 
 func (x *ShortenURLReq) Reset() {
@@ -72,6 +79,41 @@ func (x *ShortenURLReq) GetUrl() string {
 	return ""
 }
 
+func (x *ShortenURLReq) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *ShortenURLReq) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ShortenURLReq) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *ShortenURLReq) GetMaxClicks() int32 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *ShortenURLReq) GetRedirectCode() int32 {
+	if x != nil {
+		return x.RedirectCode
+	}
+	return 0
+}
+
 type ShortenURLResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -263,16 +305,23 @@ func (x *GetOrigURLReq) GetId() string {
 }
 
 // GetUsersURLs
-type GetUsersURLsResp struct {
+type GetUsersURLsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response []*GetUsersURLsResp_UrlUnit `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
+	Limit       int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor      string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Search      string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Domain      string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Sort        string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order       string                 `protobuf:"bytes,8,opt,name=order,proto3" json:"order,omitempty"`
 }
 
-func (x *GetUsersURLsResp) Reset() {
-	*x = GetUsersURLsResp{}
+func (x *GetUsersURLsReq) Reset() {
+	*x = GetUsersURLsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_grpc_url_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,13 +329,13 @@ func (x *GetUsersURLsResp) Reset() {
 	}
 }
 
-func (x *GetUsersURLsResp) String() string {
+func (x *GetUsersURLsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersURLsResp) ProtoMessage() {}
+func (*GetUsersURLsReq) ProtoMessage() {}
 
-func (x *GetUsersURLsResp) ProtoReflect() protoreflect.Message {
+func (x *GetUsersURLsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_api_grpc_url_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,29 +347,78 @@ func (x *GetUsersURLsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersURLsResp.ProtoReflect.Descriptor instead.
-func (*GetUsersURLsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUsersURLsReq.ProtoReflect.Descriptor instead.
+func (*GetUsersURLsReq) Descriptor() ([]byte, []int) {
 	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetUsersURLsResp) GetResponse() []*GetUsersURLsResp_UrlUnit {
+func (x *GetUsersURLsReq) GetLimit() int32 {
 	if x != nil {
-		return x.Response
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUsersURLsReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetUsersURLsReq) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetUsersURLsReq) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *GetUsersURLsReq) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
 	}
 	return nil
 }
 
-// DeleteUserURLs
-type DelUserURLsReq struct {
+func (x *GetUsersURLsReq) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetUsersURLsReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetUsersURLsReq) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+type GetUsersURLsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Response   []*GetUsersURLsResp_UrlUnit `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
+	NextCursor string                      `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *DelUserURLsReq) Reset() {
-	*x = DelUserURLsReq{}
+func (x *GetUsersURLsResp) Reset() {
+	*x = GetUsersURLsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_grpc_url_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,13 +426,13 @@ func (x *DelUserURLsReq) Reset() {
 	}
 }
 
-func (x *DelUserURLsReq) String() string {
+func (x *GetUsersURLsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserURLsReq) ProtoMessage() {}
+func (*GetUsersURLsResp) ProtoMessage() {}
 
-func (x *DelUserURLsReq) ProtoReflect() protoreflect.Message {
+func (x *GetUsersURLsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_api_grpc_url_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -346,29 +444,39 @@ func (x *DelUserURLsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserURLsReq.ProtoReflect.Descriptor instead.
-func (*DelUserURLsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUsersURLsResp.ProtoReflect.Descriptor instead.
+func (*GetUsersURLsResp) Descriptor() ([]byte, []int) {
 	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DelUserURLsReq) GetIds() []string {
+func (x *GetUsersURLsResp) GetResponse() []*GetUsersURLsResp_UrlUnit {
 	if x != nil {
-		return x.Ids
+		return x.Response
 	}
 	return nil
 }
 
-type ShortenURLsBatchReq_UrlUnit struct {
+func (x *GetUsersURLsResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// GetURLStats
+type GetURLStatsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	OriginalUrl   string `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Bucket string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *ShortenURLsBatchReq_UrlUnit) Reset() {
-	*x = ShortenURLsBatchReq_UrlUnit{}
+func (x *GetURLStatsReq) Reset() {
+	*x = GetURLStatsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_grpc_url_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,13 +484,13 @@ func (x *ShortenURLsBatchReq_UrlUnit) Reset() {
 	}
 }
 
-func (x *ShortenURLsBatchReq_UrlUnit) String() string {
+func (x *GetURLStatsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortenURLsBatchReq_UrlUnit) ProtoMessage() {}
+func (*GetURLStatsReq) ProtoMessage() {}
 
-func (x *ShortenURLsBatchReq_UrlUnit) ProtoReflect() protoreflect.Message {
+func (x *GetURLStatsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_api_grpc_url_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,36 +502,53 @@ func (x *ShortenURLsBatchReq_UrlUnit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortenURLsBatchReq_UrlUnit.ProtoReflect.Descriptor instead.
-func (*ShortenURLsBatchReq_UrlUnit) Descriptor() ([]byte, []int) {
-	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use GetURLStatsReq.ProtoReflect.Descriptor instead.
+func (*GetURLStatsReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ShortenURLsBatchReq_UrlUnit) GetCorrelationId() string {
+func (x *GetURLStatsReq) GetId() string {
 	if x != nil {
-		return x.CorrelationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ShortenURLsBatchReq_UrlUnit) GetOriginalUrl() string {
+func (x *GetURLStatsReq) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OriginalUrl
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetURLStatsReq) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetURLStatsReq) GetBucket() string {
+	if x != nil {
+		return x.Bucket
 	}
 	return ""
 }
 
-type ShortenURLsBatchResp_UrlUnit struct {
+type GetURLStatsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	ShortUrl      string `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	TotalClicks    int64                      `protobuf:"varint,1,opt,name=total_clicks,json=totalClicks,proto3" json:"total_clicks,omitempty"`
+	UniqueVisitors int64                      `protobuf:"varint,2,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`
+	Buckets        []*GetURLStatsResp_Bucket  `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TopReferrers   []*GetURLStatsResp_Counter `protobuf:"bytes,4,rep,name=top_referrers,json=topReferrers,proto3" json:"top_referrers,omitempty"`
+	TopUserAgents  []*GetURLStatsResp_Counter `protobuf:"bytes,5,rep,name=top_user_agents,json=topUserAgents,proto3" json:"top_user_agents,omitempty"`
 }
 
-func (x *ShortenURLsBatchResp_UrlUnit) Reset() {
-	*x = ShortenURLsBatchResp_UrlUnit{}
+func (x *GetURLStatsResp) Reset() {
+	*x = GetURLStatsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_grpc_url_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,13 +556,13 @@ func (x *ShortenURLsBatchResp_UrlUnit) Reset() {
 	}
 }
 
-func (x *ShortenURLsBatchResp_UrlUnit) String() string {
+func (x *GetURLStatsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortenURLsBatchResp_UrlUnit) ProtoMessage() {}
+func (*GetURLStatsResp) ProtoMessage() {}
 
-func (x *ShortenURLsBatchResp_UrlUnit) ProtoReflect() protoreflect.Message {
+func (x *GetURLStatsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_api_grpc_url_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -449,51 +574,137 @@ func (x *ShortenURLsBatchResp_UrlUnit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortenURLsBatchResp_UrlUnit.ProtoReflect.Descriptor instead.
-func (*ShortenURLsBatchResp_UrlUnit) Descriptor() ([]byte, []int) {
-	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use GetURLStatsResp.ProtoReflect.Descriptor instead.
+func (*GetURLStatsResp) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ShortenURLsBatchResp_UrlUnit) GetCorrelationId() string {
+func (x *GetURLStatsResp) GetTotalClicks() int64 {
 	if x != nil {
-		return x.CorrelationId
+		return x.TotalClicks
+	}
+	return 0
+}
+
+func (x *GetURLStatsResp) GetUniqueVisitors() int64 {
+	if x != nil {
+		return x.UniqueVisitors
+	}
+	return 0
+}
+
+func (x *GetURLStatsResp) GetBuckets() []*GetURLStatsResp_Bucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetURLStatsResp) GetTopReferrers() []*GetURLStatsResp_Counter {
+	if x != nil {
+		return x.TopReferrers
+	}
+	return nil
+}
+
+func (x *GetURLStatsResp) GetTopUserAgents() []*GetURLStatsResp_Counter {
+	if x != nil {
+		return x.TopUserAgents
+	}
+	return nil
+}
+
+// UpdateURL
+type UpdateURLReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url          string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	RedirectCode int32  `protobuf:"varint,3,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
+}
+
+func (x *UpdateURLReq) Reset() {
+	*x = UpdateURLReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateURLReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateURLReq) ProtoMessage() {}
+
+func (x *UpdateURLReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateURLReq.ProtoReflect.Descriptor instead.
+func (*UpdateURLReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateURLReq) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ShortenURLsBatchResp_UrlUnit) GetShortUrl() string {
+func (x *UpdateURLReq) GetUrl() string {
 	if x != nil {
-		return x.ShortUrl
+		return x.Url
 	}
 	return ""
 }
 
-type GetUsersURLsResp_UrlUnit struct {
+func (x *UpdateURLReq) GetRedirectCode() int32 {
+	if x != nil {
+		return x.RedirectCode
+	}
+	return 0
+}
+
+type UpdateURLResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ShortUrl    string `protobuf:"bytes,1,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
 	OriginalUrl string `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	DisplayUrl  string `protobuf:"bytes,3,opt,name=display_url,json=displayUrl,proto3" json:"display_url,omitempty"`
 }
 
-func (x *GetUsersURLsResp_UrlUnit) Reset() {
-	*x = GetUsersURLsResp_UrlUnit{}
+func (x *UpdateURLResp) Reset() {
+	*x = UpdateURLResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_url_service_proto_msgTypes[9]
+		mi := &file_api_grpc_url_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUsersURLsResp_UrlUnit) String() string {
+func (x *UpdateURLResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersURLsResp_UrlUnit) ProtoMessage() {}
+func (*UpdateURLResp) ProtoMessage() {}
 
-func (x *GetUsersURLsResp_UrlUnit) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_url_service_proto_msgTypes[9]
+func (x *UpdateURLResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,172 +715,1226 @@ func (x *GetUsersURLsResp_UrlUnit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersURLsResp_UrlUnit.ProtoReflect.Descriptor instead.
-func (*GetUsersURLsResp_UrlUnit) Descriptor() ([]byte, []int) {
-	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use UpdateURLResp.ProtoReflect.Descriptor instead.
+func (*UpdateURLResp) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetUsersURLsResp_UrlUnit) GetShortUrl() string {
+func (x *UpdateURLResp) GetShortUrl() string {
 	if x != nil {
 		return x.ShortUrl
 	}
 	return ""
 }
 
-func (x *GetUsersURLsResp_UrlUnit) GetOriginalUrl() string {
+func (x *UpdateURLResp) GetOriginalUrl() string {
 	if x != nil {
 		return x.OriginalUrl
 	}
 	return ""
 }
 
-var File_api_grpc_url_service_proto protoreflect.FileDescriptor
+func (x *UpdateURLResp) GetDisplayUrl() string {
+	if x != nil {
+		return x.DisplayUrl
+	}
+	return ""
+}
 
-var file_api_grpc_url_service_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x75, 0x72, 0x6c, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x75, 0x72,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
-	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x0d,
-	0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
-	0x03, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55,
-	0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75,
-	0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65,
-	0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x2e, 0x55, 0x72,
-	0x6c, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x5d,
-	0x0a, 0x07, 0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x2b, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88, 0x01, 0x01,
-	0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0xab, 0x01,
-	0x0a, 0x14, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c,
-	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x72, 0x6c, 0x55, 0x6e,
-	0x69, 0x74, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x4d, 0x0a, 0x07,
-	0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x1f, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9f, 0x01, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x40, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x2e, 0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x49, 0x0a, 0x07, 0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0x22,
-	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71,
-	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69,
-	0x64, 0x73, 0x32, 0xf2, 0x03, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x12,
-	0x19, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x72, 0x6c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55,
-	0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b,
-	0x2f, 0x67, 0x77, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x73,
-	0x0a, 0x10, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x1f, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f,
-	0x67, 0x77, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x3a, 0x01, 0x2a, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a,
-	0x12, 0x08, 0x2f, 0x67, 0x77, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x67, 0x77, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x2f, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x5e, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1a, 0x2e, 0x75, 0x72, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52,
-	0x4c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x18, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x0d, 0x2f, 0x67, 0x77, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f,
-	0x75, 0x72, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x42, 0x38, 0x5a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x67,
-	0x72, 0x70, 0x63, 0x2f, 0x75, 0x72, 0x6c, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3b,
-	0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x92, 0x41, 0x14, 0x12, 0x12, 0x0a,
-	0x0b, 0x55, 0x72, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x03, 0x31, 0x2e,
-	0x30, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// GetUsersDeletedURLs
+type GetUsersDeletedURLsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response []*GetUsersDeletedURLsResp_UrlUnit `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
 }
 
-var (
-	file_api_grpc_url_service_proto_rawDescOnce sync.Once
-	file_api_grpc_url_service_proto_rawDescData = file_api_grpc_url_service_proto_rawDesc
-)
+func (x *GetUsersDeletedURLsResp) Reset() {
+	*x = GetUsersDeletedURLsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_grpc_url_service_proto_rawDescGZIP() []byte {
-	file_api_grpc_url_service_proto_rawDescOnce.Do(func() {
-		file_api_grpc_url_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_grpc_url_service_proto_rawDescData)
-	})
-	return file_api_grpc_url_service_proto_rawDescData
+func (x *GetUsersDeletedURLsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_grpc_url_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_api_grpc_url_service_proto_goTypes = []interface{}{
-	(*ShortenURLReq)(nil),                // 0: urlService.ShortenURLReq
-	(*ShortenURLResp)(nil),               // 1: urlService.ShortenURLResp
-	(*ShortenURLsBatchReq)(nil),          // 2: urlService.ShortenURLsBatchReq
-	(*ShortenURLsBatchResp)(nil),         // 3: urlService.ShortenURLsBatchResp
-	(*GetOrigURLReq)(nil),                // 4: urlService.GetOrigURLReq
-	(*GetUsersURLsResp)(nil),             // 5: urlService.GetUsersURLsResp
-	(*DelUserURLsReq)(nil),               // 6: urlService.DelUserURLsReq
-	(*ShortenURLsBatchReq_UrlUnit)(nil),  // 7: urlService.ShortenURLsBatchReq.UrlUnit
-	(*ShortenURLsBatchResp_UrlUnit)(nil), // 8: urlService.ShortenURLsBatchResp.UrlUnit
-	(*GetUsersURLsResp_UrlUnit)(nil),     // 9: urlService.GetUsersURLsResp.UrlUnit
-	(*emptypb.Empty)(nil),                // 10: google.protobuf.Empty
+func (*GetUsersDeletedURLsResp) ProtoMessage() {}
+
+func (x *GetUsersDeletedURLsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_grpc_url_service_proto_depIdxs = []int32{
-	7,  // 0: urlService.ShortenURLsBatchReq.request:type_name -> urlService.ShortenURLsBatchReq.UrlUnit
-	8,  // 1: urlService.ShortenURLsBatchResp.response:type_name -> urlService.ShortenURLsBatchResp.UrlUnit
-	9,  // 2: urlService.GetUsersURLsResp.response:type_name -> urlService.GetUsersURLsResp.UrlUnit
-	0,  // 3: urlService.URLService.ShortenURL:input_type -> urlService.ShortenURLReq
-	2,  // 4: urlService.URLService.ShortenURLsBatch:input_type -> urlService.ShortenURLsBatchReq
-	4,  // 5: urlService.URLService.GetOriginalURL:input_type -> urlService.GetOrigURLReq
-	10, // 6: urlService.URLService.GetUsersURLs:input_type -> google.protobuf.Empty
-	6,  // 7: urlService.URLService.DeleteUserURLs:input_type -> urlService.DelUserURLsReq
-	1,  // 8: urlService.URLService.ShortenURL:output_type -> urlService.ShortenURLResp
-	3,  // 9: urlService.URLService.ShortenURLsBatch:output_type -> urlService.ShortenURLsBatchResp
-	10, // 10: urlService.URLService.GetOriginalURL:output_type -> google.protobuf.Empty
-	5,  // 11: urlService.URLService.GetUsersURLs:output_type -> urlService.GetUsersURLsResp
-	10, // 12: urlService.URLService.DeleteUserURLs:output_type -> google.protobuf.Empty
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+// Deprecated: Use GetUsersDeletedURLsResp.ProtoReflect.Descriptor instead.
+func (*GetUsersDeletedURLsResp) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{11}
 }
 
-func init() { file_api_grpc_url_service_proto_init() }
-func file_api_grpc_url_service_proto_init() {
-	if File_api_grpc_url_service_proto != nil {
-		return
+func (x *GetUsersDeletedURLsResp) GetResponse() []*GetUsersDeletedURLsResp_UrlUnit {
+	if x != nil {
+		return x.Response
 	}
-	if !protoimpl.UnsafeEnabled {
+	return nil
+}
+
+// RestoreURL
+type RestoreURLReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreURLReq) Reset() {
+	*x = RestoreURLReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreURLReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreURLReq) ProtoMessage() {}
+
+func (x *RestoreURLReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreURLReq.ProtoReflect.Descriptor instead.
+func (*RestoreURLReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreURLReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreURLResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortUrl    string `protobuf:"bytes,1,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	OriginalUrl string `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	DisplayUrl  string `protobuf:"bytes,3,opt,name=display_url,json=displayUrl,proto3" json:"display_url,omitempty"`
+}
+
+func (x *RestoreURLResp) Reset() {
+	*x = RestoreURLResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreURLResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreURLResp) ProtoMessage() {}
+
+func (x *RestoreURLResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreURLResp.ProtoReflect.Descriptor instead.
+func (*RestoreURLResp) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreURLResp) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *RestoreURLResp) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *RestoreURLResp) GetDisplayUrl() string {
+	if x != nil {
+		return x.DisplayUrl
+	}
+	return ""
+}
+
+// DeleteUserURLs
+type DelUserURLsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *DelUserURLsReq) Reset() {
+	*x = DelUserURLsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelUserURLsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelUserURLsReq) ProtoMessage() {}
+
+func (x *DelUserURLsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelUserURLsReq.ProtoReflect.Descriptor instead.
+func (*DelUserURLsReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DelUserURLsReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type DelUserURLsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *DelUserURLsResp) Reset() {
+	*x = DelUserURLsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelUserURLsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelUserURLsResp) ProtoMessage() {}
+
+func (x *DelUserURLsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelUserURLsResp.ProtoReflect.Descriptor instead.
+func (*DelUserURLsResp) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DelUserURLsResp) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+// GetDeletionJob
+type GetDeletionJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetDeletionJobReq) Reset() {
+	*x = GetDeletionJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeletionJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletionJobReq) ProtoMessage() {}
+
+func (x *GetDeletionJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletionJobReq.ProtoReflect.Descriptor instead.
+func (*GetDeletionJobReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetDeletionJobReq) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+type GetDeletionJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job       string                        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Status    string                        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp        `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Urls      []*GetDeletionJobResp_UrlUnit `protobuf:"bytes,4,rep,name=urls,proto3" json:"urls,omitempty"`
+}
+
+func (x *GetDeletionJobResp) Reset() {
+	*x = GetDeletionJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeletionJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletionJobResp) ProtoMessage() {}
+
+func (x *GetDeletionJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletionJobResp.ProtoReflect.Descriptor instead.
+func (*GetDeletionJobResp) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDeletionJobResp) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *GetDeletionJobResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetDeletionJobResp) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetDeletionJobResp) GetUrls() []*GetDeletionJobResp_UrlUnit {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type ShortenURLsBatchReq_UrlUnit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string                 `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	OriginalUrl   string                 `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxClicks     int32                  `protobuf:"varint,5,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`
+	RedirectCode  int32                  `protobuf:"varint,6,opt,name=redirect_code,json=redirectCode,proto3" json:"redirect_code,omitempty"`
+}
+
+func (x *ShortenURLsBatchReq_UrlUnit) Reset() {
+	*x = ShortenURLsBatchReq_UrlUnit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortenURLsBatchReq_UrlUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortenURLsBatchReq_UrlUnit) ProtoMessage() {}
+
+func (x *ShortenURLsBatchReq_UrlUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortenURLsBatchReq_UrlUnit.ProtoReflect.Descriptor instead.
+func (*ShortenURLsBatchReq_UrlUnit) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *ShortenURLsBatchReq_UrlUnit) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *ShortenURLsBatchReq_UrlUnit) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *ShortenURLsBatchReq_UrlUnit) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ShortenURLsBatchReq_UrlUnit) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *ShortenURLsBatchReq_UrlUnit) GetMaxClicks() int32 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *ShortenURLsBatchReq_UrlUnit) GetRedirectCode() int32 {
+	if x != nil {
+		return x.RedirectCode
+	}
+	return 0
+}
+
+type ShortenURLsBatchResp_UrlUnit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	ShortUrl      string `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+}
+
+func (x *ShortenURLsBatchResp_UrlUnit) Reset() {
+	*x = ShortenURLsBatchResp_UrlUnit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortenURLsBatchResp_UrlUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortenURLsBatchResp_UrlUnit) ProtoMessage() {}
+
+func (x *ShortenURLsBatchResp_UrlUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortenURLsBatchResp_UrlUnit.ProtoReflect.Descriptor instead.
+func (*ShortenURLsBatchResp_UrlUnit) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ShortenURLsBatchResp_UrlUnit) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *ShortenURLsBatchResp_UrlUnit) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+type GetUsersURLsResp_UrlUnit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortUrl    string `protobuf:"bytes,1,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	OriginalUrl string `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	DisplayUrl  string `protobuf:"bytes,3,opt,name=display_url,json=displayUrl,proto3" json:"display_url,omitempty"`
+}
+
+func (x *GetUsersURLsResp_UrlUnit) Reset() {
+	*x = GetUsersURLsResp_UrlUnit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersURLsResp_UrlUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersURLsResp_UrlUnit) ProtoMessage() {}
+
+func (x *GetUsersURLsResp_UrlUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersURLsResp_UrlUnit.ProtoReflect.Descriptor instead.
+func (*GetUsersURLsResp_UrlUnit) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *GetUsersURLsResp_UrlUnit) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *GetUsersURLsResp_UrlUnit) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *GetUsersURLsResp_UrlUnit) GetDisplayUrl() string {
+	if x != nil {
+		return x.DisplayUrl
+	}
+	return ""
+}
+
+type GetURLStatsResp_Bucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Clicks int64                  `protobuf:"varint,2,opt,name=clicks,proto3" json:"clicks,omitempty"`
+}
+
+func (x *GetURLStatsResp_Bucket) Reset() {
+	*x = GetURLStatsResp_Bucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetURLStatsResp_Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetURLStatsResp_Bucket) ProtoMessage() {}
+
+func (x *GetURLStatsResp_Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetURLStatsResp_Bucket.ProtoReflect.Descriptor instead.
+func (*GetURLStatsResp_Bucket) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *GetURLStatsResp_Bucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetURLStatsResp_Bucket) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+type GetURLStatsResp_Counter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value  string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Clicks int64  `protobuf:"varint,2,opt,name=clicks,proto3" json:"clicks,omitempty"`
+}
+
+func (x *GetURLStatsResp_Counter) Reset() {
+	*x = GetURLStatsResp_Counter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetURLStatsResp_Counter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetURLStatsResp_Counter) ProtoMessage() {}
+
+func (x *GetURLStatsResp_Counter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetURLStatsResp_Counter.ProtoReflect.Descriptor instead.
+func (*GetURLStatsResp_Counter) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *GetURLStatsResp_Counter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GetURLStatsResp_Counter) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+type GetUsersDeletedURLsResp_UrlUnit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortUrl    string                 `protobuf:"bytes,1,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	OriginalUrl string                 `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	DeletedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DisplayUrl  string                 `protobuf:"bytes,4,opt,name=display_url,json=displayUrl,proto3" json:"display_url,omitempty"`
+}
+
+func (x *GetUsersDeletedURLsResp_UrlUnit) Reset() {
+	*x = GetUsersDeletedURLsResp_UrlUnit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersDeletedURLsResp_UrlUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersDeletedURLsResp_UrlUnit) ProtoMessage() {}
+
+func (x *GetUsersDeletedURLsResp_UrlUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersDeletedURLsResp_UrlUnit.ProtoReflect.Descriptor instead.
+func (*GetUsersDeletedURLsResp_UrlUnit) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *GetUsersDeletedURLsResp_UrlUnit) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *GetUsersDeletedURLsResp_UrlUnit) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *GetUsersDeletedURLsResp_UrlUnit) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *GetUsersDeletedURLsResp_UrlUnit) GetDisplayUrl() string {
+	if x != nil {
+		return x.DisplayUrl
+	}
+	return ""
+}
+
+type GetDeletionJobResp_UrlUnit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetDeletionJobResp_UrlUnit) Reset() {
+	*x = GetDeletionJobResp_UrlUnit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_url_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeletionJobResp_UrlUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeletionJobResp_UrlUnit) ProtoMessage() {}
+
+func (x *GetDeletionJobResp_UrlUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_url_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeletionJobResp_UrlUnit.ProtoReflect.Descriptor instead.
+func (*GetDeletionJobResp_UrlUnit) Descriptor() ([]byte, []int) {
+	return file_api_grpc_url_service_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *GetDeletionJobResp_UrlUnit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDeletionJobResp_UrlUnit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_api_grpc_url_service_proto protoreflect.FileDescriptor
+
+var file_api_grpc_url_service_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x75, 0x72, 0x6c, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x75, 0x72,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
+	0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x02,
+	0x0a, 0x0d, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x26, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b,
+	0x73, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0xfa, 0x42, 0x10, 0x1a, 0x0e, 0x30,
+	0x00, 0x30, 0xad, 0x02, 0x30, 0xae, 0x02, 0x30, 0xb3, 0x02, 0x30, 0xb4, 0x02, 0x52, 0x0c, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x82, 0x03, 0x0a, 0x13, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65,
+	0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x2e,
+	0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0xa7, 0x02, 0x0a, 0x07, 0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x88, 0x01, 0x01, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x26, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73,
+	0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0xfa, 0x42, 0x10, 0x1a, 0x0e, 0x30, 0x00,
+	0x30, 0xad, 0x02, 0x30, 0xae, 0x02, 0x30, 0xb3, 0x02, 0x30, 0xb4, 0x02, 0x52, 0x0c, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x14, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x4d, 0x0a, 0x07, 0x55, 0x72, 0x6c,
+	0x55, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x69, 0x67, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcc, 0x02, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x6f, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x52, 0x00, 0x52, 0x02, 0x69, 0x64, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x28,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa,
+	0x42, 0x0f, 0x72, 0x0d, 0x52, 0x00, 0x52, 0x03, 0x61, 0x73, 0x63, 0x52, 0x04, 0x64, 0x65, 0x73,
+	0x63, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x72,
+	0x6c, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x1a, 0x6a, 0x0a, 0x07, 0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x72, 0x6c, 0x22, 0xa8, 0x01, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2a, 0x0a, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f,
+	0x72, 0x0d, 0x52, 0x00, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x52, 0x03, 0x64, 0x61, 0x79, 0x52,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xbf, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55,
+	0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75,
+	0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x12,
+	0x4b, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x74,
+	0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x52, 0x0a, 0x06,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73,
+	0x1a, 0x37, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x22, 0x77, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0x88, 0x01, 0x01,
+	0xd0, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x13, 0xfa, 0x42, 0x10, 0x1a, 0x0e, 0x30, 0x00, 0x30, 0xad, 0x02, 0x30, 0xae, 0x02, 0x30, 0xb3,
+	0x02, 0x30, 0xb4, 0x02, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x70, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x55, 0x72, 0x6c, 0x22, 0x8a, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x47, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0xa5, 0x01, 0x0a, 0x07, 0x55, 0x72,
+	0x6c, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55,
+	0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x72,
+	0x6c, 0x22, 0x1f, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x71, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x22, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72,
+	0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x23, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x2f,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22,
+	0xe8, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x75,
+	0x72, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x72, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x72, 0x6c, 0x55, 0x6e, 0x69,
+	0x74, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x1a, 0x31, 0x0a, 0x07, 0x55, 0x72, 0x6c, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x9c, 0x08, 0x0a, 0x0a, 0x55,
+	0x52, 0x4c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f, 0x67, 0x77, 0x2f, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x73, 0x0a, 0x10, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65,
+	0x6e, 0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x2e, 0x75, 0x72, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x55,
+	0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x75, 0x72,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e,
+	0x55, 0x52, 0x4c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x67, 0x77, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x65, 0x6e, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x55, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x2e,
+	0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x69, 0x67, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x67, 0x77, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x60, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52,
+	0x4c, 0x73, 0x12, 0x1b, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x67, 0x77, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f,
+	0x75, 0x72, 0x6c, 0x73, 0x12, 0x68, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1b, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x67, 0x77, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x75,
+	0x72, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x5f,
+	0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x18, 0x2e, 0x75, 0x72,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x32, 0x12, 0x2f, 0x67, 0x77, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x2f, 0x75, 0x72, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12,
+	0x6f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23,
+	0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x67, 0x77,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x75, 0x72, 0x6c, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68,
+	0x12, 0x6a, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x19,
+	0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x72, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f,
+	0x67, 0x77, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x75, 0x72, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x63, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1a,
+	0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x55,
+	0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x75, 0x72, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a,
+	0x0d, 0x2f, 0x67, 0x77, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x75, 0x72, 0x6c, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x76, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x4a, 0x6f, 0x62, 0x12, 0x1d, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x67, 0x77, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x75, 0x72, 0x6c, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x7d, 0x42, 0x38, 0x5a, 0x1f, 0x70, 0x6b, 0x67,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x75, 0x72, 0x6c, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x3b, 0x75, 0x72, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x92, 0x41, 0x14, 0x12,
+	0x12, 0x0a, 0x0b, 0x55, 0x72, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x03,
+	0x31, 0x2e, 0x30, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_grpc_url_service_proto_rawDescOnce sync.Once
+	file_api_grpc_url_service_proto_rawDescData = file_api_grpc_url_service_proto_rawDesc
+)
+
+func file_api_grpc_url_service_proto_rawDescGZIP() []byte {
+	file_api_grpc_url_service_proto_rawDescOnce.Do(func() {
+		file_api_grpc_url_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_grpc_url_service_proto_rawDescData)
+	})
+	return file_api_grpc_url_service_proto_rawDescData
+}
+
+var file_api_grpc_url_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_api_grpc_url_service_proto_goTypes = []interface{}{
+	(*ShortenURLReq)(nil),                   // 0: urlService.ShortenURLReq
+	(*ShortenURLResp)(nil),                  // 1: urlService.ShortenURLResp
+	(*ShortenURLsBatchReq)(nil),             // 2: urlService.ShortenURLsBatchReq
+	(*ShortenURLsBatchResp)(nil),            // 3: urlService.ShortenURLsBatchResp
+	(*GetOrigURLReq)(nil),                   // 4: urlService.GetOrigURLReq
+	(*GetUsersURLsReq)(nil),                 // 5: urlService.GetUsersURLsReq
+	(*GetUsersURLsResp)(nil),                // 6: urlService.GetUsersURLsResp
+	(*GetURLStatsReq)(nil),                  // 7: urlService.GetURLStatsReq
+	(*GetURLStatsResp)(nil),                 // 8: urlService.GetURLStatsResp
+	(*UpdateURLReq)(nil),                    // 9: urlService.UpdateURLReq
+	(*UpdateURLResp)(nil),                   // 10: urlService.UpdateURLResp
+	(*GetUsersDeletedURLsResp)(nil),         // 11: urlService.GetUsersDeletedURLsResp
+	(*RestoreURLReq)(nil),                   // 12: urlService.RestoreURLReq
+	(*RestoreURLResp)(nil),                  // 13: urlService.RestoreURLResp
+	(*DelUserURLsReq)(nil),                  // 14: urlService.DelUserURLsReq
+	(*DelUserURLsResp)(nil),                 // 15: urlService.DelUserURLsResp
+	(*GetDeletionJobReq)(nil),               // 16: urlService.GetDeletionJobReq
+	(*GetDeletionJobResp)(nil),              // 17: urlService.GetDeletionJobResp
+	(*ShortenURLsBatchReq_UrlUnit)(nil),     // 18: urlService.ShortenURLsBatchReq.UrlUnit
+	(*ShortenURLsBatchResp_UrlUnit)(nil),    // 19: urlService.ShortenURLsBatchResp.UrlUnit
+	(*GetUsersURLsResp_UrlUnit)(nil),        // 20: urlService.GetUsersURLsResp.UrlUnit
+	(*GetURLStatsResp_Bucket)(nil),          // 21: urlService.GetURLStatsResp.Bucket
+	(*GetURLStatsResp_Counter)(nil),         // 22: urlService.GetURLStatsResp.Counter
+	(*GetUsersDeletedURLsResp_UrlUnit)(nil), // 23: urlService.GetUsersDeletedURLsResp.UrlUnit
+	(*GetDeletionJobResp_UrlUnit)(nil),      // 24: urlService.GetDeletionJobResp.UrlUnit
+	(*timestamppb.Timestamp)(nil),           // 25: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 26: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                   // 27: google.protobuf.Empty
+}
+var file_api_grpc_url_service_proto_depIdxs = []int32{
+	25, // 0: urlService.ShortenURLReq.expires_at:type_name -> google.protobuf.Timestamp
+	26, // 1: urlService.ShortenURLReq.ttl:type_name -> google.protobuf.Duration
+	18, // 2: urlService.ShortenURLsBatchReq.request:type_name -> urlService.ShortenURLsBatchReq.UrlUnit
+	19, // 3: urlService.ShortenURLsBatchResp.response:type_name -> urlService.ShortenURLsBatchResp.UrlUnit
+	25, // 4: urlService.GetUsersURLsReq.created_from:type_name -> google.protobuf.Timestamp
+	25, // 5: urlService.GetUsersURLsReq.created_to:type_name -> google.protobuf.Timestamp
+	20, // 6: urlService.GetUsersURLsResp.response:type_name -> urlService.GetUsersURLsResp.UrlUnit
+	25, // 7: urlService.GetURLStatsReq.from:type_name -> google.protobuf.Timestamp
+	25, // 8: urlService.GetURLStatsReq.to:type_name -> google.protobuf.Timestamp
+	21, // 9: urlService.GetURLStatsResp.buckets:type_name -> urlService.GetURLStatsResp.Bucket
+	22, // 10: urlService.GetURLStatsResp.top_referrers:type_name -> urlService.GetURLStatsResp.Counter
+	22, // 11: urlService.GetURLStatsResp.top_user_agents:type_name -> urlService.GetURLStatsResp.Counter
+	23, // 12: urlService.GetUsersDeletedURLsResp.response:type_name -> urlService.GetUsersDeletedURLsResp.UrlUnit
+	25, // 13: urlService.GetDeletionJobResp.created_at:type_name -> google.protobuf.Timestamp
+	24, // 14: urlService.GetDeletionJobResp.urls:type_name -> urlService.GetDeletionJobResp.UrlUnit
+	25, // 15: urlService.ShortenURLsBatchReq.UrlUnit.expires_at:type_name -> google.protobuf.Timestamp
+	26, // 16: urlService.ShortenURLsBatchReq.UrlUnit.ttl:type_name -> google.protobuf.Duration
+	25, // 17: urlService.GetURLStatsResp.Bucket.start:type_name -> google.protobuf.Timestamp
+	25, // 18: urlService.GetUsersDeletedURLsResp.UrlUnit.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 19: urlService.URLService.ShortenURL:input_type -> urlService.ShortenURLReq
+	2,  // 20: urlService.URLService.ShortenURLsBatch:input_type -> urlService.ShortenURLsBatchReq
+	4,  // 21: urlService.URLService.GetOriginalURL:input_type -> urlService.GetOrigURLReq
+	5,  // 22: urlService.URLService.GetUsersURLs:input_type -> urlService.GetUsersURLsReq
+	7,  // 23: urlService.URLService.GetURLStats:input_type -> urlService.GetURLStatsReq
+	9,  // 24: urlService.URLService.UpdateURL:input_type -> urlService.UpdateURLReq
+	27, // 25: urlService.URLService.GetUsersDeletedURLs:input_type -> google.protobuf.Empty
+	12, // 26: urlService.URLService.RestoreURL:input_type -> urlService.RestoreURLReq
+	14, // 27: urlService.URLService.DeleteUserURLs:input_type -> urlService.DelUserURLsReq
+	16, // 28: urlService.URLService.GetDeletionJob:input_type -> urlService.GetDeletionJobReq
+	1,  // 29: urlService.URLService.ShortenURL:output_type -> urlService.ShortenURLResp
+	3,  // 30: urlService.URLService.ShortenURLsBatch:output_type -> urlService.ShortenURLsBatchResp
+	27, // 31: urlService.URLService.GetOriginalURL:output_type -> google.protobuf.Empty
+	6,  // 32: urlService.URLService.GetUsersURLs:output_type -> urlService.GetUsersURLsResp
+	8,  // 33: urlService.URLService.GetURLStats:output_type -> urlService.GetURLStatsResp
+	10, // 34: urlService.URLService.UpdateURL:output_type -> urlService.UpdateURLResp
+	11, // 35: urlService.URLService.GetUsersDeletedURLs:output_type -> urlService.GetUsersDeletedURLsResp
+	13, // 36: urlService.URLService.RestoreURL:output_type -> urlService.RestoreURLResp
+	15, // 37: urlService.URLService.DeleteUserURLs:output_type -> urlService.DelUserURLsResp
+	17, // 38: urlService.URLService.GetDeletionJob:output_type -> urlService.GetDeletionJobResp
+	29, // [29:39] is the sub-list for method output_type
+	19, // [19:29] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_api_grpc_url_service_proto_init() }
+func file_api_grpc_url_service_proto_init() {
+	if File_api_grpc_url_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
 		file_api_grpc_url_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShortenURLReq); i {
 			case 0:
@@ -731,7 +1996,7 @@ func file_api_grpc_url_service_proto_init() {
 			}
 		}
 		file_api_grpc_url_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsersURLsResp); i {
+			switch v := v.(*GetUsersURLsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -743,7 +2008,7 @@ func file_api_grpc_url_service_proto_init() {
 			}
 		}
 		file_api_grpc_url_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelUserURLsReq); i {
+			switch v := v.(*GetUsersURLsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -755,7 +2020,7 @@ func file_api_grpc_url_service_proto_init() {
 			}
 		}
 		file_api_grpc_url_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortenURLsBatchReq_UrlUnit); i {
+			switch v := v.(*GetURLStatsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -767,7 +2032,7 @@ func file_api_grpc_url_service_proto_init() {
 			}
 		}
 		file_api_grpc_url_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortenURLsBatchResp_UrlUnit); i {
+			switch v := v.(*GetURLStatsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -779,6 +2044,138 @@ func file_api_grpc_url_service_proto_init() {
 			}
 		}
 		file_api_grpc_url_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateURLReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateURLResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersDeletedURLsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreURLReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreURLResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelUserURLsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelUserURLsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeletionJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeletionJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortenURLsBatchReq_UrlUnit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortenURLsBatchResp_UrlUnit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUsersURLsResp_UrlUnit); i {
 			case 0:
 				return &v.state
@@ -790,6 +2187,54 @@ func file_api_grpc_url_service_proto_init() {
 				return nil
 			}
 		}
+		file_api_grpc_url_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetURLStatsResp_Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetURLStatsResp_Counter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersDeletedURLsResp_UrlUnit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_url_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeletionJobResp_UrlUnit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -797,7 +2242,7 @@ func file_api_grpc_url_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_url_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
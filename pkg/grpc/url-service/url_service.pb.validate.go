@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _url_service_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on ShortenURLReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -78,6 +81,88 @@ func (m *ShortenURLReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Alias
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShortenURLReqValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShortenURLReqValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShortenURLReqValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShortenURLReqValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShortenURLReqValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShortenURLReqValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMaxClicks() < 0 {
+		err := ShortenURLReqValidationError{
+			field:  "MaxClicks",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ShortenURLReq_RedirectCode_InLookup[m.GetRedirectCode()]; !ok {
+		err := ShortenURLReqValidationError{
+			field:  "RedirectCode",
+			reason: "value must be in list [0 301 302 307 308]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ShortenURLReqMultiError(errors)
 	}
@@ -156,6 +241,14 @@ var _ interface {
 	ErrorName() string
 } = ShortenURLReqValidationError{}
 
+var _ShortenURLReq_RedirectCode_InLookup = map[int32]struct{}{
+	0:   {},
+	301: {},
+	302: {},
+	307: {},
+	308: {},
+}
+
 // Validate checks the field values on ShortenURLResp with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -632,6 +725,215 @@ var _ interface {
 	ErrorName() string
 } = GetOrigURLReqValidationError{}
 
+// Validate checks the field values on GetUsersURLsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUsersURLsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsersURLsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsersURLsReqMultiError, or nil if none found.
+func (m *GetUsersURLsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsersURLsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := GetUsersURLsReqValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	// no validation rules for Search
+
+	// no validation rules for Domain
+
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsersURLsReqValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsersURLsReqValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsersURLsReqValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsersURLsReqValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsersURLsReqValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsersURLsReqValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _GetUsersURLsReq_Sort_InLookup[m.GetSort()]; !ok {
+		err := GetUsersURLsReqValidationError{
+			field:  "Sort",
+			reason: "value must be in list [ id created_at]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetUsersURLsReq_Order_InLookup[m.GetOrder()]; !ok {
+		err := GetUsersURLsReqValidationError{
+			field:  "Order",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUsersURLsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsersURLsReqMultiError is an error wrapping multiple validation errors
+// returned by GetUsersURLsReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUsersURLsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsersURLsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsersURLsReqMultiError) AllErrors() []error { return m }
+
+// GetUsersURLsReqValidationError is the validation error returned by
+// GetUsersURLsReq.Validate if the designated constraints aren't met.
+type GetUsersURLsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsersURLsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsersURLsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsersURLsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsersURLsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsersURLsReqValidationError) ErrorName() string { return "GetUsersURLsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUsersURLsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsersURLsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsersURLsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsersURLsReqValidationError{}
+
+var _GetUsersURLsReq_Sort_InLookup = map[string]struct{}{
+	"":           {},
+	"id":         {},
+	"created_at": {},
+}
+
+var _GetUsersURLsReq_Order_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
 // Validate checks the field values on GetUsersURLsResp with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -688,6 +990,8 @@ func (m *GetUsersURLsResp) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return GetUsersURLsRespMultiError(errors)
 	}
@@ -766,42 +1070,113 @@ var _ interface {
 	ErrorName() string
 } = GetUsersURLsRespValidationError{}
 
-// Validate checks the field values on DelUserURLsReq with the rules defined in
+// Validate checks the field values on GetURLStatsReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DelUserURLsReq) Validate() error {
+func (m *GetURLStatsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DelUserURLsReq with the rules defined
+// ValidateAll checks the field values on GetURLStatsReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DelUserURLsReqMultiError,
+// result is a list of violation errors wrapped in GetURLStatsReqMultiError,
 // or nil if none found.
-func (m *DelUserURLsReq) ValidateAll() error {
+func (m *GetURLStatsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DelUserURLsReq) validate(all bool) error {
+func (m *GetURLStatsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetURLStatsReqValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetURLStatsReqValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetURLStatsReqValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetURLStatsReqValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetURLStatsReqValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetURLStatsReqValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _GetURLStatsReq_Bucket_InLookup[m.GetBucket()]; !ok {
+		err := GetURLStatsReqValidationError{
+			field:  "Bucket",
+			reason: "value must be in list [ hour day]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DelUserURLsReqMultiError(errors)
+		return GetURLStatsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// DelUserURLsReqMultiError is an error wrapping multiple validation errors
-// returned by DelUserURLsReq.ValidateAll() if the designated constraints
+// GetURLStatsReqMultiError is an error wrapping multiple validation errors
+// returned by GetURLStatsReq.ValidateAll() if the designated constraints
 // aren't met.
-type DelUserURLsReqMultiError []error
+type GetURLStatsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DelUserURLsReqMultiError) Error() string {
+func (m GetURLStatsReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -810,11 +1185,11 @@ func (m DelUserURLsReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DelUserURLsReqMultiError) AllErrors() []error { return m }
+func (m GetURLStatsReqMultiError) AllErrors() []error { return m }
 
-// DelUserURLsReqValidationError is the validation error returned by
-// DelUserURLsReq.Validate if the designated constraints aren't met.
-type DelUserURLsReqValidationError struct {
+// GetURLStatsReqValidationError is the validation error returned by
+// GetURLStatsReq.Validate if the designated constraints aren't met.
+type GetURLStatsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -822,22 +1197,22 @@ type DelUserURLsReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DelUserURLsReqValidationError) Field() string { return e.field }
+func (e GetURLStatsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DelUserURLsReqValidationError) Reason() string { return e.reason }
+func (e GetURLStatsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DelUserURLsReqValidationError) Cause() error { return e.cause }
+func (e GetURLStatsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DelUserURLsReqValidationError) Key() bool { return e.key }
+func (e GetURLStatsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DelUserURLsReqValidationError) ErrorName() string { return "DelUserURLsReqValidationError" }
+func (e GetURLStatsReqValidationError) ErrorName() string { return "GetURLStatsReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DelUserURLsReqValidationError) Error() string {
+func (e GetURLStatsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -849,14 +1224,14 @@ func (e DelUserURLsReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDelUserURLsReq.%s: %s%s",
+		"invalid %sGetURLStatsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DelUserURLsReqValidationError{}
+var _ error = GetURLStatsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -864,67 +1239,1804 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DelUserURLsReqValidationError{}
+} = GetURLStatsReqValidationError{}
 
-// Validate checks the field values on ShortenURLsBatchReq_UrlUnit with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ShortenURLsBatchReq_UrlUnit) Validate() error {
+var _GetURLStatsReq_Bucket_InLookup = map[string]struct{}{
+	"":     {},
+	"hour": {},
+	"day":  {},
+}
+
+// Validate checks the field values on GetURLStatsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetURLStatsResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ShortenURLsBatchReq_UrlUnit with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetURLStatsResp with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ShortenURLsBatchReq_UrlUnitMultiError, or nil if none found.
-func (m *ShortenURLsBatchReq_UrlUnit) ValidateAll() error {
+// GetURLStatsRespMultiError, or nil if none found.
+func (m *GetURLStatsResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ShortenURLsBatchReq_UrlUnit) validate(all bool) error {
+func (m *GetURLStatsResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CorrelationId
+	// no validation rules for TotalClicks
 
-	if uri, err := url.Parse(m.GetOriginalUrl()); err != nil {
-		err = ShortenURLsBatchReq_UrlUnitValidationError{
-			field:  "OriginalUrl",
-			reason: "value must be a valid URI",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	} else if !uri.IsAbs() {
+	// no validation rules for UniqueVisitors
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetURLStatsRespValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetURLStatsRespValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetURLStatsRespValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopReferrers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetURLStatsRespValidationError{
+						field:  fmt.Sprintf("TopReferrers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetURLStatsRespValidationError{
+						field:  fmt.Sprintf("TopReferrers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetURLStatsRespValidationError{
+					field:  fmt.Sprintf("TopReferrers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopUserAgents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetURLStatsRespValidationError{
+						field:  fmt.Sprintf("TopUserAgents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetURLStatsRespValidationError{
+						field:  fmt.Sprintf("TopUserAgents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetURLStatsRespValidationError{
+					field:  fmt.Sprintf("TopUserAgents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetURLStatsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetURLStatsRespMultiError is an error wrapping multiple validation errors
+// returned by GetURLStatsResp.ValidateAll() if the designated constraints
+// aren't met.
+type GetURLStatsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetURLStatsRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetURLStatsRespMultiError) AllErrors() []error { return m }
+
+// GetURLStatsRespValidationError is the validation error returned by
+// GetURLStatsResp.Validate if the designated constraints aren't met.
+type GetURLStatsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetURLStatsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetURLStatsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetURLStatsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetURLStatsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetURLStatsRespValidationError) ErrorName() string { return "GetURLStatsRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetURLStatsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetURLStatsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetURLStatsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetURLStatsRespValidationError{}
+
+// Validate checks the field values on UpdateURLReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateURLReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateURLReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateURLReqMultiError, or
+// nil if none found.
+func (m *UpdateURLReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateURLReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetUrl() != "" {
+
+		if uri, err := url.Parse(m.GetUrl()); err != nil {
+			err = UpdateURLReqValidationError{
+				field:  "Url",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := UpdateURLReqValidationError{
+				field:  "Url",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _UpdateURLReq_RedirectCode_InLookup[m.GetRedirectCode()]; !ok {
+		err := UpdateURLReqValidationError{
+			field:  "RedirectCode",
+			reason: "value must be in list [0 301 302 307 308]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateURLReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateURLReqMultiError is an error wrapping multiple validation errors
+// returned by UpdateURLReq.ValidateAll() if the designated constraints aren't met.
+type UpdateURLReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateURLReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateURLReqMultiError) AllErrors() []error { return m }
+
+// UpdateURLReqValidationError is the validation error returned by
+// UpdateURLReq.Validate if the designated constraints aren't met.
+type UpdateURLReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateURLReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateURLReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateURLReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateURLReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateURLReqValidationError) ErrorName() string { return "UpdateURLReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateURLReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateURLReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateURLReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateURLReqValidationError{}
+
+var _UpdateURLReq_RedirectCode_InLookup = map[int32]struct{}{
+	0:   {},
+	301: {},
+	302: {},
+	307: {},
+	308: {},
+}
+
+// Validate checks the field values on UpdateURLResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateURLResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateURLResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateURLRespMultiError, or
+// nil if none found.
+func (m *UpdateURLResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateURLResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShortUrl
+
+	// no validation rules for OriginalUrl
+
+	// no validation rules for DisplayUrl
+
+	if len(errors) > 0 {
+		return UpdateURLRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateURLRespMultiError is an error wrapping multiple validation errors
+// returned by UpdateURLResp.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateURLRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateURLRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateURLRespMultiError) AllErrors() []error { return m }
+
+// UpdateURLRespValidationError is the validation error returned by
+// UpdateURLResp.Validate if the designated constraints aren't met.
+type UpdateURLRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateURLRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateURLRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateURLRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateURLRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateURLRespValidationError) ErrorName() string { return "UpdateURLRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateURLRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateURLResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateURLRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateURLRespValidationError{}
+
+// Validate checks the field values on GetUsersDeletedURLsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsersDeletedURLsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsersDeletedURLsResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsersDeletedURLsRespMultiError, or nil if none found.
+func (m *GetUsersDeletedURLsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsersDeletedURLsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResponse() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUsersDeletedURLsRespValidationError{
+						field:  fmt.Sprintf("Response[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUsersDeletedURLsRespValidationError{
+						field:  fmt.Sprintf("Response[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUsersDeletedURLsRespValidationError{
+					field:  fmt.Sprintf("Response[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUsersDeletedURLsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsersDeletedURLsRespMultiError is an error wrapping multiple validation
+// errors returned by GetUsersDeletedURLsResp.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsersDeletedURLsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsersDeletedURLsRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsersDeletedURLsRespMultiError) AllErrors() []error { return m }
+
+// GetUsersDeletedURLsRespValidationError is the validation error returned by
+// GetUsersDeletedURLsResp.Validate if the designated constraints aren't met.
+type GetUsersDeletedURLsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsersDeletedURLsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsersDeletedURLsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsersDeletedURLsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsersDeletedURLsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsersDeletedURLsRespValidationError) ErrorName() string {
+	return "GetUsersDeletedURLsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsersDeletedURLsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsersDeletedURLsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsersDeletedURLsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsersDeletedURLsRespValidationError{}
+
+// Validate checks the field values on RestoreURLReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RestoreURLReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreURLReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RestoreURLReqMultiError, or
+// nil if none found.
+func (m *RestoreURLReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreURLReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RestoreURLReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreURLReqMultiError is an error wrapping multiple validation errors
+// returned by RestoreURLReq.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreURLReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreURLReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreURLReqMultiError) AllErrors() []error { return m }
+
+// RestoreURLReqValidationError is the validation error returned by
+// RestoreURLReq.Validate if the designated constraints aren't met.
+type RestoreURLReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreURLReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreURLReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreURLReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreURLReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreURLReqValidationError) ErrorName() string { return "RestoreURLReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RestoreURLReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreURLReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreURLReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreURLReqValidationError{}
+
+// Validate checks the field values on RestoreURLResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RestoreURLResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreURLResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RestoreURLRespMultiError,
+// or nil if none found.
+func (m *RestoreURLResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreURLResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShortUrl
+
+	// no validation rules for OriginalUrl
+
+	// no validation rules for DisplayUrl
+
+	if len(errors) > 0 {
+		return RestoreURLRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreURLRespMultiError is an error wrapping multiple validation errors
+// returned by RestoreURLResp.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreURLRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreURLRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreURLRespMultiError) AllErrors() []error { return m }
+
+// RestoreURLRespValidationError is the validation error returned by
+// RestoreURLResp.Validate if the designated constraints aren't met.
+type RestoreURLRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreURLRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreURLRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreURLRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreURLRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreURLRespValidationError) ErrorName() string { return "RestoreURLRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RestoreURLRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreURLResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreURLRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreURLRespValidationError{}
+
+// Validate checks the field values on DelUserURLsReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DelUserURLsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DelUserURLsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DelUserURLsReqMultiError,
+// or nil if none found.
+func (m *DelUserURLsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DelUserURLsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DelUserURLsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DelUserURLsReqMultiError is an error wrapping multiple validation errors
+// returned by DelUserURLsReq.ValidateAll() if the designated constraints
+// aren't met.
+type DelUserURLsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DelUserURLsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DelUserURLsReqMultiError) AllErrors() []error { return m }
+
+// DelUserURLsReqValidationError is the validation error returned by
+// DelUserURLsReq.Validate if the designated constraints aren't met.
+type DelUserURLsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DelUserURLsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DelUserURLsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DelUserURLsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DelUserURLsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DelUserURLsReqValidationError) ErrorName() string { return "DelUserURLsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DelUserURLsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDelUserURLsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DelUserURLsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DelUserURLsReqValidationError{}
+
+// Validate checks the field values on DelUserURLsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DelUserURLsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DelUserURLsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DelUserURLsRespMultiError, or nil if none found.
+func (m *DelUserURLsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DelUserURLsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Job
+
+	if len(errors) > 0 {
+		return DelUserURLsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// DelUserURLsRespMultiError is an error wrapping multiple validation errors
+// returned by DelUserURLsResp.ValidateAll() if the designated constraints
+// aren't met.
+type DelUserURLsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DelUserURLsRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DelUserURLsRespMultiError) AllErrors() []error { return m }
+
+// DelUserURLsRespValidationError is the validation error returned by
+// DelUserURLsResp.Validate if the designated constraints aren't met.
+type DelUserURLsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DelUserURLsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DelUserURLsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DelUserURLsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DelUserURLsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DelUserURLsRespValidationError) ErrorName() string { return "DelUserURLsRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DelUserURLsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDelUserURLsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DelUserURLsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DelUserURLsRespValidationError{}
+
+// Validate checks the field values on GetDeletionJobReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetDeletionJobReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeletionJobReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeletionJobReqMultiError, or nil if none found.
+func (m *GetDeletionJobReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeletionJobReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetJob()); err != nil {
+		err = GetDeletionJobReqValidationError{
+			field:  "Job",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDeletionJobReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetDeletionJobReq) _validateUuid(uuid string) error {
+	if matched := _url_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetDeletionJobReqMultiError is an error wrapping multiple validation errors
+// returned by GetDeletionJobReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetDeletionJobReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeletionJobReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeletionJobReqMultiError) AllErrors() []error { return m }
+
+// GetDeletionJobReqValidationError is the validation error returned by
+// GetDeletionJobReq.Validate if the designated constraints aren't met.
+type GetDeletionJobReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeletionJobReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeletionJobReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeletionJobReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeletionJobReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeletionJobReqValidationError) ErrorName() string {
+	return "GetDeletionJobReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeletionJobReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeletionJobReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeletionJobReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeletionJobReqValidationError{}
+
+// Validate checks the field values on GetDeletionJobResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeletionJobResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeletionJobResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeletionJobRespMultiError, or nil if none found.
+func (m *GetDeletionJobResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeletionJobResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Job
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeletionJobRespValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeletionJobRespValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeletionJobRespValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetUrls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDeletionJobRespValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDeletionJobRespValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDeletionJobRespValidationError{
+					field:  fmt.Sprintf("Urls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDeletionJobRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeletionJobRespMultiError is an error wrapping multiple validation errors
+// returned by GetDeletionJobResp.ValidateAll() if the designated constraints
+// aren't met.
+type GetDeletionJobRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeletionJobRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeletionJobRespMultiError) AllErrors() []error { return m }
+
+// GetDeletionJobRespValidationError is the validation error returned by
+// GetDeletionJobResp.Validate if the designated constraints aren't met.
+type GetDeletionJobRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeletionJobRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeletionJobRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeletionJobRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeletionJobRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeletionJobRespValidationError) ErrorName() string {
+	return "GetDeletionJobRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeletionJobRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeletionJobResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeletionJobRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeletionJobRespValidationError{}
+
+// Validate checks the field values on ShortenURLsBatchReq_UrlUnit with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShortenURLsBatchReq_UrlUnit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShortenURLsBatchReq_UrlUnit with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShortenURLsBatchReq_UrlUnitMultiError, or nil if none found.
+func (m *ShortenURLsBatchReq_UrlUnit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShortenURLsBatchReq_UrlUnit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CorrelationId
+
+	if uri, err := url.Parse(m.GetOriginalUrl()); err != nil {
+		err = ShortenURLsBatchReq_UrlUnitValidationError{
+			field:  "OriginalUrl",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := ShortenURLsBatchReq_UrlUnitValidationError{
+			field:  "OriginalUrl",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShortenURLsBatchReq_UrlUnitValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShortenURLsBatchReq_UrlUnitValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShortenURLsBatchReq_UrlUnitValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShortenURLsBatchReq_UrlUnitValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShortenURLsBatchReq_UrlUnitValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShortenURLsBatchReq_UrlUnitValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMaxClicks() < 0 {
+		err := ShortenURLsBatchReq_UrlUnitValidationError{
+			field:  "MaxClicks",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ShortenURLsBatchReq_UrlUnit_RedirectCode_InLookup[m.GetRedirectCode()]; !ok {
 		err := ShortenURLsBatchReq_UrlUnitValidationError{
-			field:  "OriginalUrl",
-			reason: "value must be absolute",
+			field:  "RedirectCode",
+			reason: "value must be in list [0 301 302 307 308]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ShortenURLsBatchReq_UrlUnitMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShortenURLsBatchReq_UrlUnitMultiError is an error wrapping multiple
+// validation errors returned by ShortenURLsBatchReq_UrlUnit.ValidateAll() if
+// the designated constraints aren't met.
+type ShortenURLsBatchReq_UrlUnitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShortenURLsBatchReq_UrlUnitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShortenURLsBatchReq_UrlUnitMultiError) AllErrors() []error { return m }
+
+// ShortenURLsBatchReq_UrlUnitValidationError is the validation error returned
+// by ShortenURLsBatchReq_UrlUnit.Validate if the designated constraints
+// aren't met.
+type ShortenURLsBatchReq_UrlUnitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShortenURLsBatchReq_UrlUnitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShortenURLsBatchReq_UrlUnitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShortenURLsBatchReq_UrlUnitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShortenURLsBatchReq_UrlUnitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShortenURLsBatchReq_UrlUnitValidationError) ErrorName() string {
+	return "ShortenURLsBatchReq_UrlUnitValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShortenURLsBatchReq_UrlUnitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShortenURLsBatchReq_UrlUnit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShortenURLsBatchReq_UrlUnitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShortenURLsBatchReq_UrlUnitValidationError{}
+
+var _ShortenURLsBatchReq_UrlUnit_RedirectCode_InLookup = map[int32]struct{}{
+	0:   {},
+	301: {},
+	302: {},
+	307: {},
+	308: {},
+}
+
+// Validate checks the field values on ShortenURLsBatchResp_UrlUnit with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShortenURLsBatchResp_UrlUnit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShortenURLsBatchResp_UrlUnit with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShortenURLsBatchResp_UrlUnitMultiError, or nil if none found.
+func (m *ShortenURLsBatchResp_UrlUnit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShortenURLsBatchResp_UrlUnit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CorrelationId
+
+	// no validation rules for ShortUrl
+
+	if len(errors) > 0 {
+		return ShortenURLsBatchResp_UrlUnitMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShortenURLsBatchResp_UrlUnitMultiError is an error wrapping multiple
+// validation errors returned by ShortenURLsBatchResp_UrlUnit.ValidateAll() if
+// the designated constraints aren't met.
+type ShortenURLsBatchResp_UrlUnitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShortenURLsBatchResp_UrlUnitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShortenURLsBatchResp_UrlUnitMultiError) AllErrors() []error { return m }
+
+// ShortenURLsBatchResp_UrlUnitValidationError is the validation error returned
+// by ShortenURLsBatchResp_UrlUnit.Validate if the designated constraints
+// aren't met.
+type ShortenURLsBatchResp_UrlUnitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShortenURLsBatchResp_UrlUnitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShortenURLsBatchResp_UrlUnitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShortenURLsBatchResp_UrlUnitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShortenURLsBatchResp_UrlUnitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShortenURLsBatchResp_UrlUnitValidationError) ErrorName() string {
+	return "ShortenURLsBatchResp_UrlUnitValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShortenURLsBatchResp_UrlUnitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShortenURLsBatchResp_UrlUnit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShortenURLsBatchResp_UrlUnitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShortenURLsBatchResp_UrlUnitValidationError{}
+
+// Validate checks the field values on GetUsersURLsResp_UrlUnit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsersURLsResp_UrlUnit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsersURLsResp_UrlUnit with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsersURLsResp_UrlUnitMultiError, or nil if none found.
+func (m *GetUsersURLsResp_UrlUnit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsersURLsResp_UrlUnit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShortUrl
+
+	// no validation rules for OriginalUrl
+
+	// no validation rules for DisplayUrl
+
+	if len(errors) > 0 {
+		return GetUsersURLsResp_UrlUnitMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsersURLsResp_UrlUnitMultiError is an error wrapping multiple validation
+// errors returned by GetUsersURLsResp_UrlUnit.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsersURLsResp_UrlUnitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsersURLsResp_UrlUnitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsersURLsResp_UrlUnitMultiError) AllErrors() []error { return m }
+
+// GetUsersURLsResp_UrlUnitValidationError is the validation error returned by
+// GetUsersURLsResp_UrlUnit.Validate if the designated constraints aren't met.
+type GetUsersURLsResp_UrlUnitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsersURLsResp_UrlUnitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsersURLsResp_UrlUnitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsersURLsResp_UrlUnitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsersURLsResp_UrlUnitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsersURLsResp_UrlUnitValidationError) ErrorName() string {
+	return "GetUsersURLsResp_UrlUnitValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsersURLsResp_UrlUnitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsersURLsResp_UrlUnit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsersURLsResp_UrlUnitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsersURLsResp_UrlUnitValidationError{}
+
+// Validate checks the field values on GetURLStatsResp_Bucket with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetURLStatsResp_Bucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetURLStatsResp_Bucket with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetURLStatsResp_BucketMultiError, or nil if none found.
+func (m *GetURLStatsResp_Bucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetURLStatsResp_Bucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetURLStatsResp_BucketValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetURLStatsResp_BucketValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetURLStatsResp_BucketValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for Clicks
+
 	if len(errors) > 0 {
-		return ShortenURLsBatchReq_UrlUnitMultiError(errors)
+		return GetURLStatsResp_BucketMultiError(errors)
 	}
 
 	return nil
 }
 
-// ShortenURLsBatchReq_UrlUnitMultiError is an error wrapping multiple
-// validation errors returned by ShortenURLsBatchReq_UrlUnit.ValidateAll() if
-// the designated constraints aren't met.
-type ShortenURLsBatchReq_UrlUnitMultiError []error
+// GetURLStatsResp_BucketMultiError is an error wrapping multiple validation
+// errors returned by GetURLStatsResp_Bucket.ValidateAll() if the designated
+// constraints aren't met.
+type GetURLStatsResp_BucketMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ShortenURLsBatchReq_UrlUnitMultiError) Error() string {
+func (m GetURLStatsResp_BucketMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -933,12 +3045,11 @@ func (m ShortenURLsBatchReq_UrlUnitMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ShortenURLsBatchReq_UrlUnitMultiError) AllErrors() []error { return m }
+func (m GetURLStatsResp_BucketMultiError) AllErrors() []error { return m }
 
-// ShortenURLsBatchReq_UrlUnitValidationError is the validation error returned
-// by ShortenURLsBatchReq_UrlUnit.Validate if the designated constraints
-// aren't met.
-type ShortenURLsBatchReq_UrlUnitValidationError struct {
+// GetURLStatsResp_BucketValidationError is the validation error returned by
+// GetURLStatsResp_Bucket.Validate if the designated constraints aren't met.
+type GetURLStatsResp_BucketValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -946,24 +3057,24 @@ type ShortenURLsBatchReq_UrlUnitValidationError struct {
 }
 
 // Field function returns field value.
-func (e ShortenURLsBatchReq_UrlUnitValidationError) Field() string { return e.field }
+func (e GetURLStatsResp_BucketValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ShortenURLsBatchReq_UrlUnitValidationError) Reason() string { return e.reason }
+func (e GetURLStatsResp_BucketValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ShortenURLsBatchReq_UrlUnitValidationError) Cause() error { return e.cause }
+func (e GetURLStatsResp_BucketValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ShortenURLsBatchReq_UrlUnitValidationError) Key() bool { return e.key }
+func (e GetURLStatsResp_BucketValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ShortenURLsBatchReq_UrlUnitValidationError) ErrorName() string {
-	return "ShortenURLsBatchReq_UrlUnitValidationError"
+func (e GetURLStatsResp_BucketValidationError) ErrorName() string {
+	return "GetURLStatsResp_BucketValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ShortenURLsBatchReq_UrlUnitValidationError) Error() string {
+func (e GetURLStatsResp_BucketValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -975,14 +3086,14 @@ func (e ShortenURLsBatchReq_UrlUnitValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sShortenURLsBatchReq_UrlUnit.%s: %s%s",
+		"invalid %sGetURLStatsResp_Bucket.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ShortenURLsBatchReq_UrlUnitValidationError{}
+var _ error = GetURLStatsResp_BucketValidationError{}
 
 var _ interface {
 	Field() string
@@ -990,48 +3101,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ShortenURLsBatchReq_UrlUnitValidationError{}
+} = GetURLStatsResp_BucketValidationError{}
 
-// Validate checks the field values on ShortenURLsBatchResp_UrlUnit with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetURLStatsResp_Counter with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ShortenURLsBatchResp_UrlUnit) Validate() error {
+func (m *GetURLStatsResp_Counter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ShortenURLsBatchResp_UrlUnit with the
+// ValidateAll checks the field values on GetURLStatsResp_Counter with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ShortenURLsBatchResp_UrlUnitMultiError, or nil if none found.
-func (m *ShortenURLsBatchResp_UrlUnit) ValidateAll() error {
+// GetURLStatsResp_CounterMultiError, or nil if none found.
+func (m *GetURLStatsResp_Counter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ShortenURLsBatchResp_UrlUnit) validate(all bool) error {
+func (m *GetURLStatsResp_Counter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CorrelationId
+	// no validation rules for Value
 
-	// no validation rules for ShortUrl
+	// no validation rules for Clicks
 
 	if len(errors) > 0 {
-		return ShortenURLsBatchResp_UrlUnitMultiError(errors)
+		return GetURLStatsResp_CounterMultiError(errors)
 	}
 
 	return nil
 }
 
-// ShortenURLsBatchResp_UrlUnitMultiError is an error wrapping multiple
-// validation errors returned by ShortenURLsBatchResp_UrlUnit.ValidateAll() if
-// the designated constraints aren't met.
-type ShortenURLsBatchResp_UrlUnitMultiError []error
+// GetURLStatsResp_CounterMultiError is an error wrapping multiple validation
+// errors returned by GetURLStatsResp_Counter.ValidateAll() if the designated
+// constraints aren't met.
+type GetURLStatsResp_CounterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ShortenURLsBatchResp_UrlUnitMultiError) Error() string {
+func (m GetURLStatsResp_CounterMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1040,12 +3151,11 @@ func (m ShortenURLsBatchResp_UrlUnitMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ShortenURLsBatchResp_UrlUnitMultiError) AllErrors() []error { return m }
+func (m GetURLStatsResp_CounterMultiError) AllErrors() []error { return m }
 
-// ShortenURLsBatchResp_UrlUnitValidationError is the validation error returned
-// by ShortenURLsBatchResp_UrlUnit.Validate if the designated constraints
-// aren't met.
-type ShortenURLsBatchResp_UrlUnitValidationError struct {
+// GetURLStatsResp_CounterValidationError is the validation error returned by
+// GetURLStatsResp_Counter.Validate if the designated constraints aren't met.
+type GetURLStatsResp_CounterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1053,24 +3163,24 @@ type ShortenURLsBatchResp_UrlUnitValidationError struct {
 }
 
 // Field function returns field value.
-func (e ShortenURLsBatchResp_UrlUnitValidationError) Field() string { return e.field }
+func (e GetURLStatsResp_CounterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ShortenURLsBatchResp_UrlUnitValidationError) Reason() string { return e.reason }
+func (e GetURLStatsResp_CounterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ShortenURLsBatchResp_UrlUnitValidationError) Cause() error { return e.cause }
+func (e GetURLStatsResp_CounterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ShortenURLsBatchResp_UrlUnitValidationError) Key() bool { return e.key }
+func (e GetURLStatsResp_CounterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ShortenURLsBatchResp_UrlUnitValidationError) ErrorName() string {
-	return "ShortenURLsBatchResp_UrlUnitValidationError"
+func (e GetURLStatsResp_CounterValidationError) ErrorName() string {
+	return "GetURLStatsResp_CounterValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ShortenURLsBatchResp_UrlUnitValidationError) Error() string {
+func (e GetURLStatsResp_CounterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1082,14 +3192,14 @@ func (e ShortenURLsBatchResp_UrlUnitValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sShortenURLsBatchResp_UrlUnit.%s: %s%s",
+		"invalid %sGetURLStatsResp_Counter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ShortenURLsBatchResp_UrlUnitValidationError{}
+var _ error = GetURLStatsResp_CounterValidationError{}
 
 var _ interface {
 	Field() string
@@ -1097,24 +3207,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ShortenURLsBatchResp_UrlUnitValidationError{}
+} = GetURLStatsResp_CounterValidationError{}
 
-// Validate checks the field values on GetUsersURLsResp_UrlUnit with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetUsersDeletedURLsResp_UrlUnit with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUsersURLsResp_UrlUnit) Validate() error {
+func (m *GetUsersDeletedURLsResp_UrlUnit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUsersURLsResp_UrlUnit with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUsersURLsResp_UrlUnitMultiError, or nil if none found.
-func (m *GetUsersURLsResp_UrlUnit) ValidateAll() error {
+// ValidateAll checks the field values on GetUsersDeletedURLsResp_UrlUnit with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetUsersDeletedURLsResp_UrlUnitMultiError, or nil if none found.
+func (m *GetUsersDeletedURLsResp_UrlUnit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUsersURLsResp_UrlUnit) validate(all bool) error {
+func (m *GetUsersDeletedURLsResp_UrlUnit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1125,20 +3235,158 @@ func (m *GetUsersURLsResp_UrlUnit) validate(all bool) error {
 
 	// no validation rules for OriginalUrl
 
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsersDeletedURLsResp_UrlUnitValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsersDeletedURLsResp_UrlUnitValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsersDeletedURLsResp_UrlUnitValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DisplayUrl
+
 	if len(errors) > 0 {
-		return GetUsersURLsResp_UrlUnitMultiError(errors)
+		return GetUsersDeletedURLsResp_UrlUnitMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUsersURLsResp_UrlUnitMultiError is an error wrapping multiple validation
-// errors returned by GetUsersURLsResp_UrlUnit.ValidateAll() if the designated
+// GetUsersDeletedURLsResp_UrlUnitMultiError is an error wrapping multiple
+// validation errors returned by GetUsersDeletedURLsResp_UrlUnit.ValidateAll()
+// if the designated constraints aren't met.
+type GetUsersDeletedURLsResp_UrlUnitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsersDeletedURLsResp_UrlUnitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsersDeletedURLsResp_UrlUnitMultiError) AllErrors() []error { return m }
+
+// GetUsersDeletedURLsResp_UrlUnitValidationError is the validation error
+// returned by GetUsersDeletedURLsResp_UrlUnit.Validate if the designated
 // constraints aren't met.
-type GetUsersURLsResp_UrlUnitMultiError []error
+type GetUsersDeletedURLsResp_UrlUnitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsersDeletedURLsResp_UrlUnitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsersDeletedURLsResp_UrlUnitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsersDeletedURLsResp_UrlUnitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsersDeletedURLsResp_UrlUnitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsersDeletedURLsResp_UrlUnitValidationError) ErrorName() string {
+	return "GetUsersDeletedURLsResp_UrlUnitValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsersDeletedURLsResp_UrlUnitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsersDeletedURLsResp_UrlUnit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsersDeletedURLsResp_UrlUnitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsersDeletedURLsResp_UrlUnitValidationError{}
+
+// Validate checks the field values on GetDeletionJobResp_UrlUnit with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeletionJobResp_UrlUnit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeletionJobResp_UrlUnit with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeletionJobResp_UrlUnitMultiError, or nil if none found.
+func (m *GetDeletionJobResp_UrlUnit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeletionJobResp_UrlUnit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return GetDeletionJobResp_UrlUnitMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeletionJobResp_UrlUnitMultiError is an error wrapping multiple
+// validation errors returned by GetDeletionJobResp_UrlUnit.ValidateAll() if
+// the designated constraints aren't met.
+type GetDeletionJobResp_UrlUnitMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUsersURLsResp_UrlUnitMultiError) Error() string {
+func (m GetDeletionJobResp_UrlUnitMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1147,11 +3395,11 @@ func (m GetUsersURLsResp_UrlUnitMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUsersURLsResp_UrlUnitMultiError) AllErrors() []error { return m }
+func (m GetDeletionJobResp_UrlUnitMultiError) AllErrors() []error { return m }
 
-// GetUsersURLsResp_UrlUnitValidationError is the validation error returned by
-// GetUsersURLsResp_UrlUnit.Validate if the designated constraints aren't met.
-type GetUsersURLsResp_UrlUnitValidationError struct {
+// GetDeletionJobResp_UrlUnitValidationError is the validation error returned
+// by GetDeletionJobResp_UrlUnit.Validate if the designated constraints aren't met.
+type GetDeletionJobResp_UrlUnitValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1159,24 +3407,24 @@ type GetUsersURLsResp_UrlUnitValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUsersURLsResp_UrlUnitValidationError) Field() string { return e.field }
+func (e GetDeletionJobResp_UrlUnitValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUsersURLsResp_UrlUnitValidationError) Reason() string { return e.reason }
+func (e GetDeletionJobResp_UrlUnitValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUsersURLsResp_UrlUnitValidationError) Cause() error { return e.cause }
+func (e GetDeletionJobResp_UrlUnitValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUsersURLsResp_UrlUnitValidationError) Key() bool { return e.key }
+func (e GetDeletionJobResp_UrlUnitValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUsersURLsResp_UrlUnitValidationError) ErrorName() string {
-	return "GetUsersURLsResp_UrlUnitValidationError"
+func (e GetDeletionJobResp_UrlUnitValidationError) ErrorName() string {
+	return "GetDeletionJobResp_UrlUnitValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUsersURLsResp_UrlUnitValidationError) Error() string {
+func (e GetDeletionJobResp_UrlUnitValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1188,14 +3436,14 @@ func (e GetUsersURLsResp_UrlUnitValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUsersURLsResp_UrlUnit.%s: %s%s",
+		"invalid %sGetDeletionJobResp_UrlUnit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUsersURLsResp_UrlUnitValidationError{}
+var _ error = GetDeletionJobResp_UrlUnitValidationError{}
 
 var _ interface {
 	Field() string
@@ -1203,4 +3451,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUsersURLsResp_UrlUnitValidationError{}
+} = GetDeletionJobResp_UrlUnitValidationError{}
@@ -26,8 +26,13 @@ type URLServiceClient interface {
 	ShortenURL(ctx context.Context, in *ShortenURLReq, opts ...grpc.CallOption) (*ShortenURLResp, error)
 	ShortenURLsBatch(ctx context.Context, in *ShortenURLsBatchReq, opts ...grpc.CallOption) (*ShortenURLsBatchResp, error)
 	GetOriginalURL(ctx context.Context, in *GetOrigURLReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	GetUsersURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUsersURLsResp, error)
-	DeleteUserURLs(ctx context.Context, in *DelUserURLsReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUsersURLs(ctx context.Context, in *GetUsersURLsReq, opts ...grpc.CallOption) (*GetUsersURLsResp, error)
+	GetURLStats(ctx context.Context, in *GetURLStatsReq, opts ...grpc.CallOption) (*GetURLStatsResp, error)
+	UpdateURL(ctx context.Context, in *UpdateURLReq, opts ...grpc.CallOption) (*UpdateURLResp, error)
+	GetUsersDeletedURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUsersDeletedURLsResp, error)
+	RestoreURL(ctx context.Context, in *RestoreURLReq, opts ...grpc.CallOption) (*RestoreURLResp, error)
+	DeleteUserURLs(ctx context.Context, in *DelUserURLsReq, opts ...grpc.CallOption) (*DelUserURLsResp, error)
+	GetDeletionJob(ctx context.Context, in *GetDeletionJobReq, opts ...grpc.CallOption) (*GetDeletionJobResp, error)
 }
 
 type uRLServiceClient struct {
@@ -65,7 +70,7 @@ func (c *uRLServiceClient) GetOriginalURL(ctx context.Context, in *GetOrigURLReq
 	return out, nil
 }
 
-func (c *uRLServiceClient) GetUsersURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUsersURLsResp, error) {
+func (c *uRLServiceClient) GetUsersURLs(ctx context.Context, in *GetUsersURLsReq, opts ...grpc.CallOption) (*GetUsersURLsResp, error) {
 	out := new(GetUsersURLsResp)
 	err := c.cc.Invoke(ctx, "/urlService.URLService/GetUsersURLs", in, out, opts...)
 	if err != nil {
@@ -74,8 +79,44 @@ func (c *uRLServiceClient) GetUsersURLs(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
-func (c *uRLServiceClient) DeleteUserURLs(ctx context.Context, in *DelUserURLsReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *uRLServiceClient) GetURLStats(ctx context.Context, in *GetURLStatsReq, opts ...grpc.CallOption) (*GetURLStatsResp, error) {
+	out := new(GetURLStatsResp)
+	err := c.cc.Invoke(ctx, "/urlService.URLService/GetURLStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) UpdateURL(ctx context.Context, in *UpdateURLReq, opts ...grpc.CallOption) (*UpdateURLResp, error) {
+	out := new(UpdateURLResp)
+	err := c.cc.Invoke(ctx, "/urlService.URLService/UpdateURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) GetUsersDeletedURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUsersDeletedURLsResp, error) {
+	out := new(GetUsersDeletedURLsResp)
+	err := c.cc.Invoke(ctx, "/urlService.URLService/GetUsersDeletedURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) RestoreURL(ctx context.Context, in *RestoreURLReq, opts ...grpc.CallOption) (*RestoreURLResp, error) {
+	out := new(RestoreURLResp)
+	err := c.cc.Invoke(ctx, "/urlService.URLService/RestoreURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) DeleteUserURLs(ctx context.Context, in *DelUserURLsReq, opts ...grpc.CallOption) (*DelUserURLsResp, error) {
+	out := new(DelUserURLsResp)
 	err := c.cc.Invoke(ctx, "/urlService.URLService/DeleteUserURLs", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -83,6 +124,15 @@ func (c *uRLServiceClient) DeleteUserURLs(ctx context.Context, in *DelUserURLsRe
 	return out, nil
 }
 
+func (c *uRLServiceClient) GetDeletionJob(ctx context.Context, in *GetDeletionJobReq, opts ...grpc.CallOption) (*GetDeletionJobResp, error) {
+	out := new(GetDeletionJobResp)
+	err := c.cc.Invoke(ctx, "/urlService.URLService/GetDeletionJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // URLServiceServer is the server API for URLService service.
 // All implementations must embed UnimplementedURLServiceServer
 // for forward compatibility
@@ -90,8 +140,13 @@ type URLServiceServer interface {
 	ShortenURL(context.Context, *ShortenURLReq) (*ShortenURLResp, error)
 	ShortenURLsBatch(context.Context, *ShortenURLsBatchReq) (*ShortenURLsBatchResp, error)
 	GetOriginalURL(context.Context, *GetOrigURLReq) (*emptypb.Empty, error)
-	GetUsersURLs(context.Context, *emptypb.Empty) (*GetUsersURLsResp, error)
-	DeleteUserURLs(context.Context, *DelUserURLsReq) (*emptypb.Empty, error)
+	GetUsersURLs(context.Context, *GetUsersURLsReq) (*GetUsersURLsResp, error)
+	GetURLStats(context.Context, *GetURLStatsReq) (*GetURLStatsResp, error)
+	UpdateURL(context.Context, *UpdateURLReq) (*UpdateURLResp, error)
+	GetUsersDeletedURLs(context.Context, *emptypb.Empty) (*GetUsersDeletedURLsResp, error)
+	RestoreURL(context.Context, *RestoreURLReq) (*RestoreURLResp, error)
+	DeleteUserURLs(context.Context, *DelUserURLsReq) (*DelUserURLsResp, error)
+	GetDeletionJob(context.Context, *GetDeletionJobReq) (*GetDeletionJobResp, error)
 	mustEmbedUnimplementedURLServiceServer()
 }
 
@@ -108,12 +163,27 @@ func (UnimplementedURLServiceServer) ShortenURLsBatch(context.Context, *ShortenU
 func (UnimplementedURLServiceServer) GetOriginalURL(context.Context, *GetOrigURLReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOriginalURL not implemented")
 }
-func (UnimplementedURLServiceServer) GetUsersURLs(context.Context, *emptypb.Empty) (*GetUsersURLsResp, error) {
+func (UnimplementedURLServiceServer) GetUsersURLs(context.Context, *GetUsersURLsReq) (*GetUsersURLsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUsersURLs not implemented")
 }
-func (UnimplementedURLServiceServer) DeleteUserURLs(context.Context, *DelUserURLsReq) (*emptypb.Empty, error) {
+func (UnimplementedURLServiceServer) GetURLStats(context.Context, *GetURLStatsReq) (*GetURLStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetURLStats not implemented")
+}
+func (UnimplementedURLServiceServer) UpdateURL(context.Context, *UpdateURLReq) (*UpdateURLResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateURL not implemented")
+}
+func (UnimplementedURLServiceServer) GetUsersDeletedURLs(context.Context, *emptypb.Empty) (*GetUsersDeletedURLsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersDeletedURLs not implemented")
+}
+func (UnimplementedURLServiceServer) RestoreURL(context.Context, *RestoreURLReq) (*RestoreURLResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreURL not implemented")
+}
+func (UnimplementedURLServiceServer) DeleteUserURLs(context.Context, *DelUserURLsReq) (*DelUserURLsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserURLs not implemented")
 }
+func (UnimplementedURLServiceServer) GetDeletionJob(context.Context, *GetDeletionJobReq) (*GetDeletionJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletionJob not implemented")
+}
 func (UnimplementedURLServiceServer) mustEmbedUnimplementedURLServiceServer() {}
 
 // UnsafeURLServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -182,7 +252,7 @@ func _URLService_GetOriginalURL_Handler(srv interface{}, ctx context.Context, de
 }
 
 func _URLService_GetUsersURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetUsersURLsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -194,7 +264,79 @@ func _URLService_GetUsersURLs_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: "/urlService.URLService/GetUsersURLs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(URLServiceServer).GetUsersURLs(ctx, req.(*emptypb.Empty))
+		return srv.(URLServiceServer).GetUsersURLs(ctx, req.(*GetUsersURLsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_GetURLStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetURLStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).GetURLStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/urlService.URLService/GetURLStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).GetURLStats(ctx, req.(*GetURLStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_UpdateURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateURLReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).UpdateURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/urlService.URLService/UpdateURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).UpdateURL(ctx, req.(*UpdateURLReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_GetUsersDeletedURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).GetUsersDeletedURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/urlService.URLService/GetUsersDeletedURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).GetUsersDeletedURLs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_RestoreURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreURLReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).RestoreURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/urlService.URLService/RestoreURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).RestoreURL(ctx, req.(*RestoreURLReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -217,6 +359,24 @@ func _URLService_DeleteUserURLs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _URLService_GetDeletionJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletionJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).GetDeletionJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/urlService.URLService/GetDeletionJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).GetDeletionJob(ctx, req.(*GetDeletionJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // URLService_ServiceDesc is the grpc.ServiceDesc for URLService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,10 +400,30 @@ var URLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUsersURLs",
 			Handler:    _URLService_GetUsersURLs_Handler,
 		},
+		{
+			MethodName: "GetURLStats",
+			Handler:    _URLService_GetURLStats_Handler,
+		},
+		{
+			MethodName: "UpdateURL",
+			Handler:    _URLService_UpdateURL_Handler,
+		},
+		{
+			MethodName: "GetUsersDeletedURLs",
+			Handler:    _URLService_GetUsersDeletedURLs_Handler,
+		},
+		{
+			MethodName: "RestoreURL",
+			Handler:    _URLService_RestoreURL_Handler,
+		},
 		{
 			MethodName: "DeleteUserURLs",
 			Handler:    _URLService_DeleteUserURLs_Handler,
 		},
+		{
+			MethodName: "GetDeletionJob",
+			Handler:    _URLService_GetDeletionJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/url_service.proto",
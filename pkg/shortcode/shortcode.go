@@ -0,0 +1,97 @@
+package shortcode
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// DefaultAlphabet defines base62 alphabet.
+	DefaultAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	digits = "0123456789"
+)
+
+// Generator generates random short codes.
+type Generator struct {
+	alphabet string
+	length   int
+}
+
+// NewGenerator creates a new short codes Generator.
+func NewGenerator(alphabet string, length int) (Generator, error) {
+	if length < 1 {
+		return Generator{}, fmt.Errorf("length: too small value")
+	}
+
+	if len(alphabet) < 2 {
+		return Generator{}, fmt.Errorf("alphabet: too short")
+	}
+
+	for idx := 0; idx < len(alphabet); idx++ {
+		char := alphabet[idx]
+		if !isCodeChar(char) {
+			return Generator{}, fmt.Errorf("alphabet: unsupported character %q", char)
+		}
+		if strings.IndexByte(alphabet[idx+1:], char) != -1 {
+			return Generator{}, fmt.Errorf("alphabet: duplicate character %q", char)
+		}
+	}
+
+	if strings.Trim(alphabet, digits) == "" {
+		return Generator{}, fmt.Errorf("alphabet: digits only")
+	}
+
+	return Generator{alphabet: alphabet, length: length}, nil
+}
+
+// Generate returns a new random short code.
+// Digits only codes are reserved for legacy numeric ids and never generated.
+func (g Generator) Generate() (string, error) {
+	base := big.NewInt(int64(len(g.alphabet)))
+	code := make([]byte, g.length)
+
+	for {
+		for idx := range code {
+			n, err := rand.Int(rand.Reader, base)
+			if err != nil {
+				return "", fmt.Errorf("generating short code: %w", err)
+			}
+			code[idx] = g.alphabet[n.Int64()]
+		}
+
+		if !IsLegacy(string(code)) {
+			return string(code), nil
+		}
+	}
+}
+
+// IsLegacy checks whether the code is a legacy numeric id.
+func IsLegacy(code string) bool {
+	return code != "" && strings.Trim(code, digits) == ""
+}
+
+// Validate checks the code consists of supported characters only.
+func Validate(code string) error {
+	if code == "" {
+		return fmt.Errorf("empty")
+	}
+
+	for idx := 0; idx < len(code); idx++ {
+		if !isCodeChar(code[idx]) {
+			return fmt.Errorf("unsupported character %q", code[idx])
+		}
+	}
+
+	return nil
+}
+
+// isCodeChar checks the character is URL path safe.
+func isCodeChar(char byte) bool {
+	return char >= '0' && char <= '9' ||
+		char >= 'A' && char <= 'Z' ||
+		char >= 'a' && char <= 'z' ||
+		char == '-' || char == '_'
+}
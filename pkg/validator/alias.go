@@ -0,0 +1,41 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vstdy/go-shortener/pkg/shortcode"
+)
+
+const (
+	aliasMinLen = 3
+	aliasMaxLen = 64
+)
+
+// reservedAliases keeps router path segments an alias must not shadow.
+var reservedAliases = map[string]bool{
+	"api":  true,
+	"gw":   true,
+	"ping": true,
+}
+
+// ValidateAlias validates custom short code.
+func ValidateAlias(alias string) error {
+	if len(alias) < aliasMinLen || len(alias) > aliasMaxLen {
+		return fmt.Errorf("length must be between %d and %d", aliasMinLen, aliasMaxLen)
+	}
+
+	if err := shortcode.Validate(alias); err != nil {
+		return err
+	}
+
+	if shortcode.IsLegacy(alias) {
+		return fmt.Errorf("digits only aliases are reserved")
+	}
+
+	if reservedAliases[strings.ToLower(alias)] {
+		return fmt.Errorf("%q is reserved", alias)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// redirectCodes keeps supported redirect status codes.
+var redirectCodes = map[int]bool{
+	http.StatusMovedPermanently:  true,
+	http.StatusFound:             true,
+	http.StatusTemporaryRedirect: true,
+	http.StatusPermanentRedirect: true,
+}
+
+// ValidateRedirectCode validates redirect status code.
+func ValidateRedirectCode(code int) error {
+	if !redirectCodes[code] {
+		return fmt.Errorf("unsupported status code %d", code)
+	}
+
+	return nil
+}
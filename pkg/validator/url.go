@@ -0,0 +1,223 @@
+package validator
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// URL policy violation reasons.
+const (
+	ReasonMalformedURL     = "malformed_url"
+	ReasonTooLong          = "too_long"
+	ReasonSchemeNotAllowed = "scheme_not_allowed"
+	ReasonSelfLink         = "self_link"
+	ReasonIPHost           = "ip_host"
+	ReasonPrivateHost      = "private_host"
+	ReasonHostBlocked      = "host_blocked"
+	ReasonHostNotAllowed   = "host_not_allowed"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range not covered by net.IP.IsPrivate.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+type (
+	// URLViolation describes url rejected by URLPolicy.
+	URLViolation struct {
+		Reason  string
+		Message string
+	}
+
+	// URLPolicy keeps url validation rules.
+	// Host lists match the domain itself along with its subdomains,
+	// empty AllowedHosts allows any host not blocked.
+	URLPolicy struct {
+		Schemes            []string
+		MaxLength          int
+		BlockedHosts       []string
+		AllowedHosts       []string
+		RejectIPHosts      bool
+		RejectPrivateHosts bool
+		BaseURL            string
+	}
+)
+
+// Error implements error interface.
+func (v *URLViolation) Error() string {
+	return v.Message
+}
+
+// NewDefaultURLPolicy builds a URLPolicy with default values.
+func NewDefaultURLPolicy() URLPolicy {
+	return URLPolicy{
+		Schemes:            []string{"http", "https"},
+		MaxLength:          2048,
+		RejectPrivateHosts: true,
+	}
+}
+
+// ValidateURL validates url against the default policy, violations are returned as *URLViolation.
+func ValidateURL(urlRaw string) error {
+	return NewDefaultURLPolicy().Validate(urlRaw)
+}
+
+// Validate validates url against the policy, violations are returned as *URLViolation.
+// Hosts are checked as written, names aren't resolved.
+func (p URLPolicy) Validate(urlRaw string) error {
+	if err := p.ValidateLength(urlRaw); err != nil {
+		return err
+	}
+
+	u, err := url.ParseRequestURI(urlRaw)
+	if err != nil {
+		return violation(ReasonMalformedURL, "%v", err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if len(p.Schemes) > 0 && !containsFold(p.Schemes, scheme) {
+		return violation(ReasonSchemeNotAllowed, "scheme %q is not allowed", scheme)
+	}
+
+	// opaque urls like mailto: have no host to check
+	if u.Host == "" {
+		return nil
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return violation(ReasonMalformedURL, "host: empty")
+	}
+
+	if selfHost := hostOf(p.BaseURL); selfHost != "" && host == selfHost {
+		return violation(ReasonSelfLink, "url points to the shortener itself")
+	}
+
+	if err = p.validateAddress(host); err != nil {
+		return err
+	}
+
+	for _, domain := range p.BlockedHosts {
+		if matchesDomain(host, domain) {
+			return violation(ReasonHostBlocked, "host %q is blocked", host)
+		}
+	}
+
+	if len(p.AllowedHosts) == 0 {
+		return nil
+	}
+	for _, domain := range p.AllowedHosts {
+		if matchesDomain(host, domain) {
+			return nil
+		}
+	}
+
+	return violation(ReasonHostNotAllowed, "host %q is not allowed", host)
+}
+
+// ValidateLength validates url length against the policy, violation is returned as *URLViolation.
+// Used for urls kept as given along with their canonical form.
+func (p URLPolicy) ValidateLength(urlRaw string) error {
+	if p.MaxLength > 0 && len(urlRaw) > p.MaxLength {
+		return violation(ReasonTooLong, "length exceeds %d", p.MaxLength)
+	}
+
+	return nil
+}
+
+// validateAddress checks whether the host is an IP literal or points to a private network.
+// Hosts with numeric top level label are taken for non-canonical IP literals like 2130706433.
+func (p URLPolicy) validateAddress(host string) error {
+	if !p.RejectIPHosts && !p.RejectPrivateHosts {
+		return nil
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		if isNumericLabel(host[strings.LastIndex(host, ".")+1:]) {
+			return violation(ReasonIPHost, "host %q is not a canonical IP address", host)
+		}
+
+		if p.RejectPrivateHosts && (host == "localhost" || strings.HasSuffix(host, ".localhost")) {
+			return violation(ReasonPrivateHost, "host %q is private", host)
+		}
+
+		return nil
+	}
+
+	if p.RejectIPHosts {
+		return violation(ReasonIPHost, "IP address hosts are not allowed")
+	}
+
+	if p.RejectPrivateHosts && isPrivateIP(ip) {
+		return violation(ReasonPrivateHost, "host %q is private", host)
+	}
+
+	return nil
+}
+
+// violation creates a URLViolation with formatted message.
+func violation(reason, format string, args ...interface{}) *URLViolation {
+	return &URLViolation{Reason: reason, Message: fmt.Sprintf(format, args...)}
+}
+
+// hostOf returns lowercased host name of given url, empty string is returned for invalid url.
+func hostOf(urlRaw string) string {
+	if urlRaw == "" {
+		return ""
+	}
+
+	u, err := url.Parse(urlRaw)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+}
+
+// matchesDomain checks whether the host is the domain or its subdomain.
+func matchesDomain(host, domain string) bool {
+	domain = strings.Trim(strings.ToLower(domain), ".")
+	if domain == "" {
+		return false
+	}
+
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// containsFold checks whether the list contains given value ignoring case.
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNumericLabel checks whether the host label consists of digits or is a hex number.
+func isNumericLabel(label string) bool {
+	digits := label
+	if strings.HasPrefix(label, "0x") {
+		digits = label[2:]
+	}
+	if digits == "" {
+		return false
+	}
+	for _, r := range digits {
+		if !strings.ContainsRune("0123456789abcdef", r) || (digits == label && r > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPrivateIP checks whether the IP address belongs to loopback, private, link-local,
+// shared or unspecified address space.
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		sharedAddressSpace.Contains(ip)
+}
@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLPolicy_Validate(t *testing.T) {
+	policy := URLPolicy{
+		Schemes:       []string{"https", "mailto"},
+		MaxLength:     64,
+		BlockedHosts:  []string{"ads.example.com"},
+		AllowedHosts:  []string{"example.com", ".example.org"},
+		RejectIPHosts: true,
+		BaseURL:       "https://Short.example.com:8443",
+	}
+
+	testCases := []struct {
+		name   string
+		url    string
+		reason string
+	}{
+		{name: "Allowed host", url: "https://example.com/a"},
+		{name: "Allowed subdomain", url: "https://www.EXAMPLE.org./a"},
+		{name: "Opaque url", url: "mailto:user@example.net"},
+		{name: "Malformed url", url: "example.com", reason: ReasonMalformedURL},
+		{name: "Empty host", url: "https://:443/", reason: ReasonMalformedURL},
+		{name: "Scheme not allowed", url: "http://example.com/", reason: ReasonSchemeNotAllowed},
+		{name: "Too long", url: "https://example.com/?q=01234567890123456789012345678901234567890123456789", reason: ReasonTooLong},
+		{name: "Self link on other port", url: "https://short.example.com/a1B2c3D", reason: ReasonSelfLink},
+		{name: "Public IP", url: "https://93.184.216.34/", reason: ReasonIPHost},
+		{name: "Hex IP", url: "https://0x7f000001/", reason: ReasonIPHost},
+		{name: "Blocked subdomain", url: "https://x.ads.example.com/", reason: ReasonHostBlocked},
+		{name: "Host not allowed", url: "https://example.net/", reason: ReasonHostNotAllowed},
+		{name: "Lookalike host", url: "https://badexample.com/", reason: ReasonHostNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Validate(tc.url)
+			if tc.reason == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var violation *URLViolation
+			require.True(t, errors.As(err, &violation), err)
+			assert.Equal(t, tc.reason, violation.Reason)
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	assert.NoError(t, ValidateURL("https://lengthy-url.com/"))
+
+	for _, url := range []string{"lengthy-url.com", "javascript:alert(1)", "http://localhost/admin"} {
+		var violation *URLViolation
+		assert.True(t, errors.As(ValidateURL(url), &violation), url)
+	}
+}
+
+func TestURLPolicy_Validate_PrivateHosts(t *testing.T) {
+	policy := URLPolicy{RejectPrivateHosts: true}
+
+	for _, url := range []string{
+		"http://localhost:3000/", "http://api.localhost/", "http://192.168.1.1/", "http://172.16.0.10/",
+		"http://169.254.169.254/latest/meta-data", "http://100.64.0.1/", "http://0.0.0.0/", "http://[fd00::1]/",
+	} {
+		var violation *URLViolation
+		require.True(t, errors.As(policy.Validate(url), &violation), url)
+		assert.Equal(t, ReasonPrivateHost, violation.Reason, url)
+	}
+
+	assert.NoError(t, policy.Validate("http://93.184.216.34/"))
+	assert.NoError(t, policy.Validate("ftp://example.com/"))
+}
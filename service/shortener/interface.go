@@ -14,15 +14,30 @@ type Service interface {
 	io.Closer
 
 	// AddURL adds given object to storage.
+	// Object short code is used as a custom alias when set.
 	AddURL(ctx context.Context, obj *model.URL) error
 	// AddURLsBatch adds given batch of objects to storage.
 	AddURLsBatch(ctx context.Context, objs *[]model.URL) error
-	// GetURL gets object with given id.
-	GetURL(ctx context.Context, urlID int) (string, error)
-	// GetUsersURLs gets current user objects.
-	GetUsersURLs(ctx context.Context, userID uuid.UUID) ([]model.URL, error)
-	// RemoveUsersURLs removes current user objects with given ids.
-	RemoveUsersURLs(ctx context.Context, objs []model.URL) error
+	// GetURL gets object with given short code.
+	// Gone objects are returned empty, redirect code defaults to 307 Temporary Redirect.
+	GetURL(ctx context.Context, code string) (model.URL, error)
+	// GetUsersURLs gets a page of current user objects matching list filter in list order.
+	GetUsersURLs(ctx context.Context, userID uuid.UUID, params model.ListParams) (model.URLsPage, error)
+	// UpdateURL updates url and redirect code of current user object with given short code.
+	UpdateURL(ctx context.Context, obj *model.URL) error
+	// GetUsersDeletedURLs gets current user objects deleted within restore grace period.
+	GetUsersDeletedURLs(ctx context.Context, userID uuid.UUID) ([]model.URL, error)
+	// RestoreURL restores current user object with given short code deleted within restore grace period.
+	RestoreURL(ctx context.Context, obj *model.URL) error
+	// RemoveUsersURLs removes current user objects with given short codes.
+	// Objects are removed asynchronously, returns the id of the deletion job tracking their removal.
+	RemoveUsersURLs(ctx context.Context, objs []model.URL) (uuid.UUID, error)
+	// GetDeletionJob gets current user deletion job with given id along with its deletion outcomes.
+	GetDeletionJob(ctx context.Context, userID, jobID uuid.UUID) (model.DeletionJob, error)
+	// AddClick queues given click object for recording.
+	AddClick(ctx context.Context, obj model.Click) error
+	// GetURLStats gets clicks statistics of current user object with given short code.
+	GetURLStats(ctx context.Context, userID uuid.UUID, code string, params model.StatsParams) (model.URLStats, error)
 	// Ping verifies a connection to the database is still alive.
 	Ping() error
 }
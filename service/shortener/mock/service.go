@@ -36,6 +36,20 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AddClick mocks base method.
+func (m *MockService) AddClick(ctx context.Context, obj model.Click) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddClick", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddClick indicates an expected call of AddClick.
+func (mr *MockServiceMockRecorder) AddClick(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClick", reflect.TypeOf((*MockService)(nil).AddClick), ctx, obj)
+}
+
 // AddURL mocks base method.
 func (m *MockService) AddURL(ctx context.Context, obj *model.URL) error {
 	m.ctrl.T.Helper()
@@ -78,34 +92,79 @@ func (mr *MockServiceMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockService)(nil).Close))
 }
 
+// GetDeletionJob mocks base method.
+func (m *MockService) GetDeletionJob(ctx context.Context, userID, jobID uuid.UUID) (model.DeletionJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletionJob", ctx, userID, jobID)
+	ret0, _ := ret[0].(model.DeletionJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletionJob indicates an expected call of GetDeletionJob.
+func (mr *MockServiceMockRecorder) GetDeletionJob(ctx, userID, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletionJob", reflect.TypeOf((*MockService)(nil).GetDeletionJob), ctx, userID, jobID)
+}
+
 // GetURL mocks base method.
-func (m *MockService) GetURL(ctx context.Context, urlID int) (string, error) {
+func (m *MockService) GetURL(ctx context.Context, code string) (model.URL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetURL", ctx, urlID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetURL", ctx, code)
+	ret0, _ := ret[0].(model.URL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetURL indicates an expected call of GetURL.
-func (mr *MockServiceMockRecorder) GetURL(ctx, urlID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetURL(ctx, code interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetURL", reflect.TypeOf((*MockService)(nil).GetURL), ctx, urlID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetURL", reflect.TypeOf((*MockService)(nil).GetURL), ctx, code)
 }
 
-// GetUsersURLs mocks base method.
-func (m *MockService) GetUsersURLs(ctx context.Context, userID uuid.UUID) ([]model.URL, error) {
+// GetURLStats mocks base method.
+func (m *MockService) GetURLStats(ctx context.Context, userID uuid.UUID, code string, params model.StatsParams) (model.URLStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersURLs", ctx, userID)
+	ret := m.ctrl.Call(m, "GetURLStats", ctx, userID, code, params)
+	ret0, _ := ret[0].(model.URLStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetURLStats indicates an expected call of GetURLStats.
+func (mr *MockServiceMockRecorder) GetURLStats(ctx, userID, code, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetURLStats", reflect.TypeOf((*MockService)(nil).GetURLStats), ctx, userID, code, params)
+}
+
+// GetUsersDeletedURLs mocks base method.
+func (m *MockService) GetUsersDeletedURLs(ctx context.Context, userID uuid.UUID) ([]model.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersDeletedURLs", ctx, userID)
 	ret0, _ := ret[0].([]model.URL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetUsersDeletedURLs indicates an expected call of GetUsersDeletedURLs.
+func (mr *MockServiceMockRecorder) GetUsersDeletedURLs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersDeletedURLs", reflect.TypeOf((*MockService)(nil).GetUsersDeletedURLs), ctx, userID)
+}
+
+// GetUsersURLs mocks base method.
+func (m *MockService) GetUsersURLs(ctx context.Context, userID uuid.UUID, params model.ListParams) (model.URLsPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersURLs", ctx, userID, params)
+	ret0, _ := ret[0].(model.URLsPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // GetUsersURLs indicates an expected call of GetUsersURLs.
-func (mr *MockServiceMockRecorder) GetUsersURLs(ctx, userID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetUsersURLs(ctx, userID, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersURLs", reflect.TypeOf((*MockService)(nil).GetUsersURLs), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersURLs", reflect.TypeOf((*MockService)(nil).GetUsersURLs), ctx, userID, params)
 }
 
 // Ping mocks base method.
@@ -123,11 +182,12 @@ func (mr *MockServiceMockRecorder) Ping() *gomock.Call {
 }
 
 // RemoveUsersURLs mocks base method.
-func (m *MockService) RemoveUsersURLs(ctx context.Context, objs []model.URL) error {
+func (m *MockService) RemoveUsersURLs(ctx context.Context, objs []model.URL) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveUsersURLs", ctx, objs)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RemoveUsersURLs indicates an expected call of RemoveUsersURLs.
@@ -135,3 +195,31 @@ func (mr *MockServiceMockRecorder) RemoveUsersURLs(ctx, objs interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUsersURLs", reflect.TypeOf((*MockService)(nil).RemoveUsersURLs), ctx, objs)
 }
+
+// RestoreURL mocks base method.
+func (m *MockService) RestoreURL(ctx context.Context, obj *model.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreURL", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreURL indicates an expected call of RestoreURL.
+func (mr *MockServiceMockRecorder) RestoreURL(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreURL", reflect.TypeOf((*MockService)(nil).RestoreURL), ctx, obj)
+}
+
+// UpdateURL mocks base method.
+func (m *MockService) UpdateURL(ctx context.Context, obj *model.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateURL", ctx, obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateURL indicates an expected call of UpdateURL.
+func (mr *MockServiceMockRecorder) UpdateURL(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateURL", reflect.TypeOf((*MockService)(nil).UpdateURL), ctx, obj)
+}
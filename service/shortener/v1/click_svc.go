@@ -0,0 +1,41 @@
+package shortener
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	"github.com/vstdy/go-shortener/pkg/tracing"
+)
+
+// AddClick queues given click object for recording.
+// Clicks are dropped when the queue is full, so redirects are never delayed.
+func (svc *Service) AddClick(ctx context.Context, obj model.Click) (err error) {
+	_, span := tracing.StartSpanFromCtx(ctx, "shortener AddClick")
+	defer tracing.FinishSpan(span, err)
+
+	if obj.URLID == 0 {
+		return fmt.Errorf("shortener: AddClick: %w: url_id: empty", pkg.ErrInvalidInput)
+	}
+
+	if obj.CreatedAt.IsZero() {
+		obj.CreatedAt = time.Now()
+	}
+
+	svc.RLock()
+	defer svc.RUnlock()
+
+	if svc.closed {
+		return fmt.Errorf("shortener: AddClick: %w", pkg.ErrServiceClosed)
+	}
+
+	select {
+	case svc.clickChan <- obj:
+	default:
+		return fmt.Errorf("shortener: AddClick: click queue is full")
+	}
+
+	return nil
+}
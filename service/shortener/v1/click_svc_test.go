@@ -0,0 +1,118 @@
+package shortener
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	storageMock "github.com/vstdy/go-shortener/storage/mock"
+)
+
+func (s *TestSuite) TestService_AddClick() {
+	type testCase struct {
+		name         string
+		prepareMocks func(StorageMock *storageMock.MockStorage) []model.Click
+		errExpected  bool
+		errTarget    error
+		errContains  string
+	}
+
+	testCases := []testCase{
+		{
+			name: "Fail: invalid input (empty url_id)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) []model.Click {
+				return []model.Click{
+					{
+						Referrer: "https://referrer.com/",
+					},
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "url_id",
+		},
+		{
+			name: "OK: full buffer",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) []model.Click {
+				input := []model.Click{
+					{
+						URLID:    1,
+						Referrer: "https://referrer.com/",
+					},
+					{
+						URLID: 2,
+						IP:    "127.0.0.1",
+					},
+				}
+
+				s.Add(1)
+
+				StorageMock.EXPECT().
+					AddClicks(gomock.Any(), gomock.Any()).
+					Do(func(ctx context.Context, objs []model.Click) {
+						defer s.Done()
+
+						s.Require().Len(objs, 2)
+						for idx := range objs {
+							s.Assert().Equal(input[idx].URLID, objs[idx].URLID)
+							s.Assert().False(objs[idx].CreatedAt.IsZero())
+						}
+					}).
+					Return(nil)
+
+				return input
+			},
+			errExpected: false,
+		},
+		{
+			name: "OK: buffer wipe timeout",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) []model.Click {
+				input := []model.Click{
+					{
+						URLID: 1,
+					},
+				}
+
+				s.Add(1)
+
+				StorageMock.EXPECT().
+					AddClicks(gomock.Any(), gomock.Any()).
+					Do(func(ctx context.Context, objs []model.Click) { s.Done() }).
+					Return(nil)
+
+				return input
+			},
+			errExpected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			input := tc.prepareMocks(s.stMock)
+
+			var err error
+			for _, obj := range input {
+				if err = s.svc.AddClick(s.ctx, obj); err != nil {
+					break
+				}
+			}
+			if tc.errExpected {
+				s.Assert().Error(err)
+				if tc.errTarget != nil {
+					s.Assert().True(errors.Is(err, tc.errTarget))
+				}
+				if tc.errContains != "" {
+					s.Assert().Contains(err.Error(), tc.errContains)
+				}
+				return
+			}
+
+			s.Wait()
+
+			s.Assert().NoError(err)
+		})
+	}
+}
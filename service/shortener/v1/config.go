@@ -2,14 +2,46 @@ package shortener
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg/canonical"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
+	"github.com/vstdy/go-shortener/pkg/validator"
 )
 
 // Config keeps Service params.
 type Config struct {
-	DelReqTimeout     time.Duration `mapstructure:"del_req_timeout"`
-	DelBufWipeTimeout time.Duration `mapstructure:"del_buf_wipe_timeout"`
-	DelBufCap         int           `mapstructure:"del_buf_cap"`
+	DelReqTimeout       time.Duration    `mapstructure:"del_req_timeout"`
+	DelBufWipeTimeout   time.Duration    `mapstructure:"del_buf_wipe_timeout"`
+	DelBufCap           int              `mapstructure:"del_buf_cap"`
+	DelDrainTimeout     time.Duration    `mapstructure:"del_drain_timeout"`
+	DelRetryInterval    time.Duration    `mapstructure:"del_retry_interval"`
+	DelRetryBackoff     time.Duration    `mapstructure:"del_retry_backoff"`
+	DelRetryMaxBackoff  time.Duration    `mapstructure:"del_retry_max_backoff"`
+	DelMaxAttempts      int              `mapstructure:"del_max_attempts"`
+	DelJobRetention     time.Duration    `mapstructure:"del_job_retention"`
+	CodeAlphabet        string           `mapstructure:"code_alphabet"`
+	CodeLength          int              `mapstructure:"code_length"`
+	CodeGenAttempts     int              `mapstructure:"code_gen_attempts"`
+	ExpReapInterval     time.Duration    `mapstructure:"exp_reap_interval"`
+	ClickReqTimeout     time.Duration    `mapstructure:"click_req_timeout"`
+	ClickBufWipeTimeout time.Duration    `mapstructure:"click_buf_wipe_timeout"`
+	ClickBufCap         int              `mapstructure:"click_buf_cap"`
+	ClickQueueCap       int              `mapstructure:"click_queue_cap"`
+	RestoreGracePeriod  time.Duration    `mapstructure:"restore_grace_period"`
+	DedupScope          model.DedupScope `mapstructure:"dedup_scope"`
+	BaseURL             string           `mapstructure:"base_url"`
+	URLSchemes          []string         `mapstructure:"url_schemes"`
+	URLMaxLength        int              `mapstructure:"url_max_length"`
+	URLBlockedHosts     []string         `mapstructure:"url_blocked_hosts"`
+	URLAllowedHosts     []string         `mapstructure:"url_allowed_hosts"`
+	URLRejectIPHosts    bool             `mapstructure:"url_reject_ip_hosts"`
+	URLRejectPrivate    bool             `mapstructure:"url_reject_private_hosts"`
+	URLSortQuery        bool             `mapstructure:"url_sort_query"`
+	URLStripParams      []string         `mapstructure:"url_strip_params"`
 }
 
 // Validate performs a basic validation.
@@ -26,14 +58,158 @@ func (config Config) Validate() error {
 		return fmt.Errorf("%s field: too small value", "del_buf_cap")
 	}
 
+	if config.DelDrainTimeout < time.Second {
+		return fmt.Errorf("%s field: too short period", "del_drain_timeout")
+	}
+
+	if config.DelRetryInterval < time.Second {
+		return fmt.Errorf("%s field: too short period", "del_retry_interval")
+	}
+
+	if config.DelRetryBackoff < time.Second {
+		return fmt.Errorf("%s field: too short period", "del_retry_backoff")
+	}
+
+	if config.DelRetryMaxBackoff < config.DelRetryBackoff {
+		return fmt.Errorf("%s field: shorter than %s", "del_retry_max_backoff", "del_retry_backoff")
+	}
+
+	if config.DelMaxAttempts < 1 {
+		return fmt.Errorf("%s field: too small value", "del_max_attempts")
+	}
+
+	if config.DelJobRetention < time.Minute {
+		return fmt.Errorf("%s field: too short period", "del_job_retention")
+	}
+
+	if len(config.CodeAlphabet) < 2 {
+		return fmt.Errorf("%s field: too short", "code_alphabet")
+	}
+
+	if config.CodeLength < 4 {
+		return fmt.Errorf("%s field: too small value", "code_length")
+	}
+
+	if config.CodeGenAttempts < 1 {
+		return fmt.Errorf("%s field: too small value", "code_gen_attempts")
+	}
+
+	if config.ExpReapInterval < time.Second {
+		return fmt.Errorf("%s field: too short period", "exp_reap_interval")
+	}
+
+	if config.ClickReqTimeout < time.Second {
+		return fmt.Errorf("%s field: too short period", "click_req_timeout")
+	}
+
+	if config.ClickBufWipeTimeout < time.Second {
+		return fmt.Errorf("%s field: too short period", "click_buf_wipe_timeout")
+	}
+
+	if config.ClickBufCap < 1 {
+		return fmt.Errorf("%s field: too small value", "click_buf_cap")
+	}
+
+	if config.ClickQueueCap < 1 {
+		return fmt.Errorf("%s field: too small value", "click_queue_cap")
+	}
+
+	if config.RestoreGracePeriod < time.Minute {
+		return fmt.Errorf("%s field: too short period", "restore_grace_period")
+	}
+
+	switch config.DedupScope {
+	case model.DedupScopeGlobal, model.DedupScopeUser:
+	default:
+		return fmt.Errorf("%s field: unsupported value %q", "dedup_scope", config.DedupScope)
+	}
+
+	if config.BaseURL != "" {
+		if u, err := url.Parse(config.BaseURL); err != nil || u.Hostname() == "" {
+			return fmt.Errorf("%s field: invalid url", "base_url")
+		}
+	}
+
+	if len(config.URLSchemes) == 0 {
+		return fmt.Errorf("%s field: empty", "url_schemes")
+	}
+	for idx, scheme := range config.URLSchemes {
+		if scheme == "" {
+			return fmt.Errorf("%s field: empty scheme [%d]", "url_schemes", idx)
+		}
+	}
+
+	if config.URLMaxLength < 16 {
+		return fmt.Errorf("%s field: too small value", "url_max_length")
+	}
+
+	for key, hosts := range map[string][]string{
+		"url_blocked_hosts": config.URLBlockedHosts,
+		"url_allowed_hosts": config.URLAllowedHosts,
+	} {
+		for idx, host := range hosts {
+			if strings.Trim(host, ".") == "" {
+				return fmt.Errorf("%s field: empty host [%d]", key, idx)
+			}
+		}
+	}
+
+	for idx, param := range config.URLStripParams {
+		if strings.TrimSuffix(param, "*") == "" {
+			return fmt.Errorf("%s field: empty param [%d]", "url_strip_params", idx)
+		}
+	}
+
 	return nil
 }
 
+// URLPolicy builds url validation policy.
+func (config Config) URLPolicy() validator.URLPolicy {
+	return validator.URLPolicy{
+		Schemes:            config.URLSchemes,
+		MaxLength:          config.URLMaxLength,
+		BlockedHosts:       config.URLBlockedHosts,
+		AllowedHosts:       config.URLAllowedHosts,
+		RejectIPHosts:      config.URLRejectIPHosts,
+		RejectPrivateHosts: config.URLRejectPrivate,
+		BaseURL:            config.BaseURL,
+	}
+}
+
+// URLCanonicalizer builds url canonicalizer.
+func (config Config) URLCanonicalizer() canonical.Canonicalizer {
+	return canonical.Canonicalizer{
+		SortQuery:   config.URLSortQuery,
+		StripParams: config.URLStripParams,
+	}
+}
+
 // NewDefaultConfig builds a Config with default values.
 func NewDefaultConfig() Config {
 	return Config{
-		DelReqTimeout:     5 * time.Second,
-		DelBufWipeTimeout: 5 * time.Second,
-		DelBufCap:         10,
+		DelReqTimeout:       5 * time.Second,
+		DelBufWipeTimeout:   5 * time.Second,
+		DelBufCap:           10,
+		DelDrainTimeout:     10 * time.Second,
+		DelRetryInterval:    10 * time.Second,
+		DelRetryBackoff:     5 * time.Second,
+		DelRetryMaxBackoff:  10 * time.Minute,
+		DelMaxAttempts:      10,
+		DelJobRetention:     24 * time.Hour,
+		CodeAlphabet:        shortcode.DefaultAlphabet,
+		CodeLength:          7,
+		CodeGenAttempts:     5,
+		ExpReapInterval:     time.Minute,
+		ClickReqTimeout:     5 * time.Second,
+		ClickBufWipeTimeout: 5 * time.Second,
+		ClickBufCap:         100,
+		ClickQueueCap:       1000,
+		RestoreGracePeriod:  72 * time.Hour,
+		DedupScope:          model.DedupScopeGlobal,
+		BaseURL:             "http://127.0.0.1:8080",
+		URLSchemes:          []string{"http", "https"},
+		URLMaxLength:        2048,
+		URLRejectPrivate:    true,
+		URLStripParams:      []string{"utm_*", "fbclid", "gclid"},
 	}
 }
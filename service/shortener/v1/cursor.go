@@ -0,0 +1,117 @@
+package shortener
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+const (
+	listDefaultLimit = 100
+	listMaxLimit     = 1000
+
+	cursorDescPrefix = "d"
+)
+
+// resolveListParams sets default list params and validates them.
+// Returns storage query of the page.
+func resolveListParams(params *model.ListParams) (model.URLsQuery, error) {
+	if params.Limit == 0 {
+		params.Limit = listDefaultLimit
+	}
+	if params.Sort == "" {
+		params.Sort = model.ListSortID
+	}
+
+	if params.Limit < 0 || params.Limit > listMaxLimit {
+		return model.URLsQuery{}, fmt.Errorf("limit: must be between 1 and %d", listMaxLimit)
+	}
+
+	if params.Sort != model.ListSortID && params.Sort != model.ListSortCreatedAt {
+		return model.URLsQuery{}, fmt.Errorf("sort: unsupported value %q", params.Sort)
+	}
+
+	filter := params.Filter
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		return model.URLsQuery{}, fmt.Errorf("created_from: must be before created_to")
+	}
+
+	query := model.URLsQuery{
+		Filter: filter,
+		Sort:   params.Sort,
+		Desc:   params.Desc,
+		Limit:  params.Limit,
+	}
+
+	if params.Cursor == "" {
+		return query, nil
+	}
+
+	afterID, afterCreatedAt, err := decodeCursor(params.Cursor, params.Sort, params.Desc)
+	if err != nil {
+		return model.URLsQuery{}, fmt.Errorf("cursor: malformed")
+	}
+	query.AfterID = afterID
+	query.AfterCreatedAt = afterCreatedAt
+
+	return query, nil
+}
+
+// encodeCursor encodes position of the last object of a page to an opaque cursor.
+// Creation time is encoded for pages ordered by creation time only,
+// position of a page in descending order is prefixed with cursorDescPrefix.
+func encodeCursor(obj model.URL, sort model.ListSort, desc bool) string {
+	pos := strconv.Itoa(obj.ID)
+	if sort == model.ListSortCreatedAt {
+		pos += "." + strconv.FormatInt(obj.CreatedAt.UnixNano(), 10)
+	}
+	if desc {
+		pos = cursorDescPrefix + pos
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(pos))
+}
+
+// decodeCursor decodes an opaque cursor to position of the last object of a page.
+// Cursors of pages in another sort or direction are rejected.
+func decodeCursor(cursor string, sort model.ListSort, desc bool) (int, time.Time, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	pos := string(data)
+	if strings.HasPrefix(pos, cursorDescPrefix) != desc {
+		return 0, time.Time{}, fmt.Errorf("cursor of another order")
+	}
+	pos = strings.TrimPrefix(pos, cursorDescPrefix)
+
+	parts := strings.SplitN(pos, ".", 2)
+	hasCreatedAt := len(parts) == 2
+	if hasCreatedAt != (sort == model.ListSortCreatedAt) {
+		return 0, time.Time{}, fmt.Errorf("cursor of another sort order")
+	}
+
+	id, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if id < 1 {
+		return 0, time.Time{}, fmt.Errorf("non-positive id")
+	}
+
+	if !hasCreatedAt {
+		return id, time.Time{}, nil
+	}
+
+	createdAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return id, time.Unix(0, createdAt), nil
+}
@@ -0,0 +1,34 @@
+package shortener
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	"github.com/vstdy/go-shortener/pkg/tracing"
+)
+
+// GetDeletionJob gets current user deletion job with given id along with its deletion outcomes.
+// Jobs of other users and jobs expired after retention period aren't found.
+func (svc *Service) GetDeletionJob(ctx context.Context, userID, jobID uuid.UUID) (job model.DeletionJob, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "shortener GetDeletionJob")
+	defer tracing.FinishSpan(span, err)
+
+	dels, err := svc.storage.GetJobDeletions(ctx, jobID)
+	if err != nil {
+		return model.DeletionJob{}, fmt.Errorf("shortener: GetDeletionJob: %w", err)
+	}
+	if len(dels) == 0 || dels[0].UserID != userID {
+		return model.DeletionJob{}, fmt.Errorf("shortener: GetDeletionJob: %w", pkg.ErrNotFound)
+	}
+
+	return model.DeletionJob{
+		ID:        jobID,
+		UserID:    userID,
+		CreatedAt: dels[0].CreatedAt,
+		Deletions: dels,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package shortener
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+)
+
+func (s *TestSuite) TestService_GetDeletionJob() {
+	userID, jobID := uuid.New(), uuid.New()
+	createdAt := time.Now().Add(-time.Minute)
+	dels := []model.Deletion{
+		{ID: 1, JobID: jobID, UserID: userID, Code: "a1B2c3D", Status: model.DeletionDeleted, CreatedAt: createdAt},
+		{ID: 2, JobID: jobID, UserID: userID, Code: "e4F5g6H", Status: model.DeletionPending, CreatedAt: createdAt},
+	}
+
+	s.Run("Fail: expired job", func() {
+		s.stMock.EXPECT().
+			GetJobDeletions(gomock.Any(), jobID).
+			Return(nil, nil)
+
+		_, err := s.svc.GetDeletionJob(s.ctx, userID, jobID)
+		s.Assert().True(errors.Is(err, pkg.ErrNotFound))
+	})
+
+	s.Run("Fail: job of another user", func() {
+		s.stMock.EXPECT().
+			GetJobDeletions(gomock.Any(), jobID).
+			Return(dels, nil)
+
+		_, err := s.svc.GetDeletionJob(s.ctx, uuid.New(), jobID)
+		s.Assert().True(errors.Is(err, pkg.ErrNotFound))
+	})
+
+	s.Run("Fail: storage error", func() {
+		s.stMock.EXPECT().
+			GetJobDeletions(gomock.Any(), jobID).
+			Return(nil, errors.New("connection refused"))
+
+		_, err := s.svc.GetDeletionJob(s.ctx, userID, jobID)
+		s.Assert().Error(err)
+		s.Assert().False(errors.Is(err, pkg.ErrNotFound))
+	})
+
+	s.Run("OK", func() {
+		s.stMock.EXPECT().
+			GetJobDeletions(gomock.Any(), jobID).
+			Return(dels, nil)
+
+		job, err := s.svc.GetDeletionJob(s.ctx, userID, jobID)
+		s.Require().NoError(err)
+		s.Assert().Equal(jobID, job.ID)
+		s.Assert().Equal(userID, job.UserID)
+		s.Assert().Equal(createdAt, job.CreatedAt)
+		s.Assert().Equal(dels, job.Deletions)
+		s.Assert().False(job.IsDone())
+	})
+}
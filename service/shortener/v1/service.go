@@ -10,7 +10,10 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg/canonical"
 	"github.com/vstdy/go-shortener/pkg/logging"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
+	"github.com/vstdy/go-shortener/pkg/validator"
 	"github.com/vstdy/go-shortener/service/shortener"
 	inter "github.com/vstdy/go-shortener/storage"
 )
@@ -26,9 +29,24 @@ type (
 	Service struct {
 		sync.RWMutex
 
-		delChan chan model.URL
-		config  Config
-		storage inter.Storage
+		closed       bool
+		delChan      chan model.Deletion
+		delSenders   sync.WaitGroup
+		delWorkers   sync.WaitGroup
+		delStop      chan struct{}
+		delCtx       context.Context
+		delCancel    context.CancelFunc
+		expWorkers   sync.WaitGroup
+		expStop      chan struct{}
+		expCtx       context.Context
+		expCancel    context.CancelFunc
+		clickChan    chan model.Click
+		clickWorkers sync.WaitGroup
+		config       Config
+		storage      inter.Storage
+		codeGen      shortcode.Generator
+		urlPolicy    validator.URLPolicy
+		urlCanon     canonical.Canonicalizer
 	}
 
 	// ServiceOption defines functional argument for Service constructor.
@@ -72,23 +90,96 @@ func NewService(opts ...ServiceOption) (*Service, error) {
 		return nil, fmt.Errorf("storage: nil")
 	}
 
-	svc.delChan = make(chan model.URL)
+	codeGen, err := shortcode.NewGenerator(svc.config.CodeAlphabet, svc.config.CodeLength)
+	if err != nil {
+		return nil, fmt.Errorf("short code generator: %w", err)
+	}
+	svc.codeGen = codeGen
+	svc.urlPolicy = svc.config.URLPolicy()
+	svc.urlCanon = svc.config.URLCanonicalizer()
+
+	svc.delChan = make(chan model.Deletion)
+	svc.delStop = make(chan struct{})
+	svc.delCtx, svc.delCancel = context.WithCancel(context.Background())
+	svc.delWorkers.Add(2)
 	go svc.delWorker(svc.config)
+	go svc.delRetryWorker(svc.config)
+
+	svc.expStop = make(chan struct{})
+	svc.expCtx, svc.expCancel = context.WithCancel(context.Background())
+	svc.expWorkers.Add(1)
+	go svc.expWorker(svc.config)
+
+	svc.clickChan = make(chan model.Click, svc.config.ClickQueueCap)
+	svc.clickWorkers.Add(1)
+	go svc.clickWorker(svc.config)
 
 	return svc, nil
 }
 
-// Close closes all service dependencies.
+// Close stops accepting new deletions and clicks, drains the deletion queue
+// within configured timeout, flushes queued clicks, stops background workers
+// and closes all service dependencies.
+// Deletions left undone stay persisted and are retried after restart.
 func (svc *Service) Close() error {
-	if svc.storage == nil {
+	svc.Lock()
+	closed := svc.closed
+	svc.closed = true
+	svc.Unlock()
+
+	if closed || svc.storage == nil {
 		return nil
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), svc.config.DelDrainTimeout)
+	defer cancel()
+
+	drainErr := svc.drainDeletions(ctx)
+	svc.stopExpiration()
+	svc.drainClicks()
+
 	if err := svc.storage.Close(); err != nil {
 		return fmt.Errorf("closing storage: %w", err)
 	}
 
-	return nil
+	return drainErr
+}
+
+// drainDeletions stops deletion retries and waits for queued deletions to be flushed to storage.
+// Deletions still in flight are cancelled once the context is done, they stay pending in storage.
+func (svc *Service) drainDeletions(ctx context.Context) error {
+	close(svc.delStop)
+
+	done := make(chan struct{})
+	go func() {
+		svc.delSenders.Wait()
+		close(svc.delChan)
+		svc.delWorkers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		svc.delCancel()
+		<-done
+		return fmt.Errorf("draining deletion queue: %w", ctx.Err())
+	}
+}
+
+// stopExpiration stops expired urls reaping, a reaping in progress is cancelled.
+func (svc *Service) stopExpiration() {
+	close(svc.expStop)
+	svc.expCancel()
+	svc.expWorkers.Wait()
+}
+
+// drainClicks closes the click queue and waits for queued clicks to be flushed to storage.
+// Flushes are bounded by the click request timeout.
+func (svc *Service) drainClicks() {
+	close(svc.clickChan)
+	svc.clickWorkers.Wait()
 }
 
 // Logger returns logger with service field set.
@@ -100,41 +191,302 @@ func (svc *Service) Logger(ctx context.Context) *zerolog.Logger {
 }
 
 // delWorker starts url deletion worker.
+// Once the deletion queue is closed, the buffer is flushed and in-flight flushes are waited for.
 func (svc *Service) delWorker(config Config) {
-	flush := func(delObjs []model.URL) {
-		if len(delObjs) == 0 {
+	defer svc.delWorkers.Done()
+
+	var flushes sync.WaitGroup
+	flush := func(dels []model.Deletion) {
+		if len(dels) == 0 {
 			return
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), config.DelReqTimeout)
-		defer cancel()
+		flushes.Add(1)
+		go func() {
+			defer flushes.Done()
 
-		err := svc.storage.RemoveUsersURLs(ctx, delObjs)
-		if err != nil {
-			log.Warn().Err(err).Msg("Objects deletion failed")
-		}
+			svc.processDeletions(config, dels)
+		}()
 	}
 
-	buffer := make([]model.URL, 0, config.DelBufCap)
+	var mu sync.Mutex
+	buffer := make([]model.Deletion, 0, config.DelBufCap)
 	timer := time.AfterFunc(config.DelBufWipeTimeout, func() {
-		svc.Lock()
-		defer svc.Unlock()
+		mu.Lock()
+		defer mu.Unlock()
 
-		go flush(buffer)
-		buffer = buffer[:0]
+		flush(buffer)
+		buffer = make([]model.Deletion, 0, config.DelBufCap)
 	})
 
-	for obj := range svc.delChan {
-		svc.Lock()
+	for del := range svc.delChan {
+		mu.Lock()
 
 		timer.Reset(config.DelBufWipeTimeout)
-		buffer = append(buffer, obj)
+		buffer = append(buffer, del)
+
+		if cap(buffer) == len(buffer) {
+			flush(buffer)
+			buffer = make([]model.Deletion, 0, config.DelBufCap)
+		}
+
+		mu.Unlock()
+	}
+
+	timer.Stop()
+
+	mu.Lock()
+	flush(buffer)
+	buffer = nil
+	mu.Unlock()
+
+	flushes.Wait()
+}
+
+// delRetryWorker starts persisted deletions retry worker.
+// Deletions accepted before restart are picked up once their queue lease expires.
+func (svc *Service) delRetryWorker(config Config) {
+	defer svc.delWorkers.Done()
+
+	ticker := time.NewTicker(config.DelRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-svc.delStop:
+			return
+		case now := <-ticker.C:
+			svc.retryDeletions(config, now)
+		}
+	}
+}
+
+// retryDeletions removes deletion jobs expired by given time
+// and processes deletions due by given time in batches.
+func (svc *Service) retryDeletions(config Config, now time.Time) {
+	ctx, cancel := context.WithTimeout(svc.delCtx, config.DelReqTimeout)
+	_, err := svc.storage.RemoveDoneDeletions(ctx, now.Add(-config.DelJobRetention))
+	cancel()
+	if err != nil {
+		log.Warn().Err(err).Msg("Expired deletion jobs removal failed")
+	}
+
+	for {
+		ctx, cancel := context.WithTimeout(svc.delCtx, config.DelReqTimeout)
+		dels, err := svc.storage.ClaimDueDeletions(ctx, now, config.DelBufCap, delLease(config))
+		cancel()
+		if err != nil {
+			log.Warn().Err(err).Msg("Pending deletions loading failed")
+			return
+		}
+
+		// deletions failed to be rescheduled would be loaded again
+		if !svc.processDeletions(config, dels) || len(dels) < config.DelBufCap {
+			return
+		}
+
+		select {
+		case <-svc.delStop:
+			return
+		default:
+		}
+	}
+}
+
+// processDeletions removes url objects of given deletions and saves their outcomes.
+// Failed deletions are rescheduled with exponential backoff,
+// deletions out of attempts are moved to dead letters.
+// Returns false if deletions state failed to be saved.
+func (svc *Service) processDeletions(config Config, dels []model.Deletion) bool {
+	if len(dels) == 0 {
+		return true
+	}
+
+	objs := make([]model.URL, 0, len(dels))
+	ids := make([]int, 0, len(dels))
+	for _, del := range dels {
+		objs = append(objs, model.URL{Code: del.Code, UserID: del.UserID})
+		ids = append(ids, del.ID)
+	}
+
+	if svc.delCtx.Err() != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(svc.delCtx, config.DelReqTimeout)
+	statuses, delErr := svc.storage.RemoveUsersURLs(ctx, objs)
+	cancel()
+
+	// deletions cancelled by the drain stay pending and are retried after restart
+	if svc.delCtx.Err() != nil {
+		return false
+	}
+
+	// deletions state is saved even if the deletion timed out
+	ctx, cancel = context.WithTimeout(svc.delCtx, config.DelReqTimeout)
+	defer cancel()
+
+	now := time.Now()
+	dels = append([]model.Deletion(nil), dels...)
+
+	if delErr == nil {
+		for idx := range dels {
+			dels[idx].Status = statuses[idx]
+			dels[idx].DoneAt = now
+		}
+
+		if err := svc.storage.UpdateDeletions(ctx, dels); err != nil {
+			log.Warn().Err(err).Ints("ids", ids).Msg("Done deletions saving failed")
+			return false
+		}
+
+		return true
+	}
+
+	log.Warn().Err(delErr).Ints("ids", ids).Msg("Objects deletion failed")
+
+	var deadIDs []int
+	for idx := range dels {
+		dels[idx].Attempts++
+		dels[idx].LastError = delErr.Error()
+		if dels[idx].Attempts >= config.DelMaxAttempts {
+			dels[idx].DeadAt = now
+			deadIDs = append(deadIDs, dels[idx].ID)
+			continue
+		}
+		dels[idx].NextAttemptAt = now.Add(delBackoff(config, dels[idx].Attempts))
+	}
+
+	if err := svc.storage.UpdateDeletions(ctx, dels); err != nil {
+		log.Warn().Err(err).Ints("ids", ids).Msg("Failed deletions rescheduling failed")
+		return false
+	}
+
+	if len(deadIDs) > 0 {
+		log.Error().Err(delErr).Ints("ids", deadIDs).Msg("Deletions are out of attempts, moved to dead letters")
+	}
+
+	return true
+}
+
+// delBackoff returns the delay before the next deletion attempt after given number of attempts.
+func delBackoff(config Config, attempts int) time.Duration {
+	backoff := config.DelRetryBackoff
+	for i := 1; i < attempts && backoff < config.DelRetryMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > config.DelRetryMaxBackoff {
+		return config.DelRetryMaxBackoff
+	}
+
+	return backoff
+}
+
+// delLease returns the period a deletion is owned by the deletion queue after acceptance or retry claim.
+// The deletion is retried from storage once the lease expires.
+func delLease(config Config) time.Duration {
+	return time.Duration(config.DelBufCap)*config.DelBufWipeTimeout + config.DelReqTimeout
+}
+
+// expWorker starts expired urls reaping worker.
+func (svc *Service) expWorker(config Config) {
+	defer svc.expWorkers.Done()
+
+	ticker := time.NewTicker(config.ExpReapInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-svc.expStop:
+			return
+		case now := <-ticker.C:
+			svc.reapExpired(config, now)
+		}
+	}
+}
+
+// reapExpired removes url objects expired by given time.
+func (svc *Service) reapExpired(config Config, now time.Time) {
+	ctx, cancel := context.WithTimeout(svc.expCtx, config.DelReqTimeout)
+	defer cancel()
+
+	cnt, err := svc.storage.RemoveExpiredURLs(ctx, now)
+	if err != nil {
+		if svc.expCtx.Err() == nil {
+			log.Warn().Err(err).Msg("Expired objects removal failed")
+		}
+		return
+	}
+	if cnt > 0 {
+		log.Info().Int("count", cnt).Msg("Expired objects removed")
+	}
+}
+
+// clickWorker starts url clicks recording worker.
+// Once the click queue is closed, the buffer is flushed and in-flight flushes are waited for.
+func (svc *Service) clickWorker(config Config) {
+	defer svc.clickWorkers.Done()
+
+	var flushes sync.WaitGroup
+	flush := func(clicks []model.Click) {
+		if len(clicks) == 0 {
+			return
+		}
+
+		flushes.Add(1)
+		go func() {
+			defer flushes.Done()
+
+			svc.recordClicks(config, clicks)
+		}()
+	}
+
+	var mu sync.Mutex
+	buffer := make([]model.Click, 0, config.ClickBufCap)
+	timer := time.AfterFunc(config.ClickBufWipeTimeout, func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		flush(buffer)
+		buffer = make([]model.Click, 0, config.ClickBufCap)
+	})
+
+	for click := range svc.clickChan {
+		mu.Lock()
+
+		timer.Reset(config.ClickBufWipeTimeout)
+		buffer = append(buffer, click)
 
 		if cap(buffer) == len(buffer) {
-			go flush(buffer)
-			buffer = buffer[:0]
+			flush(buffer)
+			buffer = make([]model.Click, 0, config.ClickBufCap)
 		}
 
-		svc.Unlock()
+		mu.Unlock()
+	}
+
+	timer.Stop()
+
+	mu.Lock()
+	clicks := buffer
+	buffer = nil
+	mu.Unlock()
+
+	svc.recordClicks(config, clicks)
+	flushes.Wait()
+}
+
+// recordClicks saves given clicks to storage.
+func (svc *Service) recordClicks(config Config, clicks []model.Click) {
+	if len(clicks) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.ClickReqTimeout)
+	defer cancel()
+
+	if err := svc.storage.AddClicks(ctx, clicks); err != nil {
+		log.Warn().Err(err).Int("count", len(clicks)).Msg("Clicks recording failed")
 	}
 }
@@ -0,0 +1,263 @@
+package shortener
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	storagemock "github.com/vstdy/go-shortener/storage/mock"
+)
+
+// assignDeletionIDs mocks deletions persisting assigning them sequential ids.
+func assignDeletionIDs(ctx context.Context, dels []model.Deletion) ([]model.Deletion, error) {
+	for idx := range dels {
+		if dels[idx].JobID == uuid.Nil || dels[idx].Status != model.DeletionPending {
+			return nil, errors.New("pending deletion job expected")
+		}
+		dels[idx].ID = idx + 1
+	}
+
+	return dels, nil
+}
+
+// newClosableService creates a service which deletion and click buffers are never wiped by timeout within a test.
+func newClosableService(t *testing.T, stMock *storagemock.MockStorage, drainTimeout time.Duration) *Service {
+	config := NewDefaultConfig()
+	config.DelBufWipeTimeout = time.Hour
+	config.DelDrainTimeout = drainTimeout
+	config.DelRetryInterval = time.Hour
+	config.ExpReapInterval = time.Hour
+	config.ClickBufWipeTimeout = time.Hour
+
+	svc, err := NewService(
+		WithConfig(config),
+		WithStorage(stMock),
+	)
+	require.NoError(t, err)
+
+	return svc
+}
+
+func TestService_Close(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	stMock := storagemock.NewMockStorage(mockCtrl)
+	svc := newClosableService(t, stMock, time.Second)
+
+	input := []model.URL{
+		{Code: "a1B2c3D", UserID: uuid.New()},
+		{Code: "e4F5g6H", UserID: uuid.New()},
+	}
+
+	gomock.InOrder(
+		stMock.EXPECT().
+			AddDeletions(gomock.Any(), gomock.Any()).
+			DoAndReturn(assignDeletionIDs),
+		stMock.EXPECT().
+			RemoveUsersURLs(gomock.Any(), input).
+			Return([]model.DeletionStatus{model.DeletionDeleted, model.DeletionDeleted}, nil),
+		stMock.EXPECT().
+			UpdateDeletions(gomock.Any(), gomock.Any()).
+			Return(nil),
+		stMock.EXPECT().
+			Close().
+			Return(nil),
+	)
+
+	_, err := svc.RemoveUsersURLs(context.TODO(), input)
+	require.NoError(t, err)
+	require.NoError(t, svc.Close())
+
+	_, err = svc.RemoveUsersURLs(context.TODO(), input)
+	assert.True(t, errors.Is(err, pkg.ErrServiceClosed))
+
+	assert.NoError(t, svc.Close())
+}
+
+func TestService_Close_DrainTimeout(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	stMock := storagemock.NewMockStorage(mockCtrl)
+	svc := newClosableService(t, stMock, time.Second)
+
+	input := []model.URL{{Code: "a1B2c3D", UserID: uuid.New()}}
+
+	gomock.InOrder(
+		stMock.EXPECT().
+			AddDeletions(gomock.Any(), gomock.Any()).
+			DoAndReturn(assignDeletionIDs),
+		stMock.EXPECT().
+			RemoveUsersURLs(gomock.Any(), input).
+			DoAndReturn(func(ctx context.Context, objs []model.URL) ([]model.DeletionStatus, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}),
+		stMock.EXPECT().
+			Close().
+			Return(nil),
+	)
+
+	_, err := svc.RemoveUsersURLs(context.TODO(), input)
+	require.NoError(t, err)
+
+	err = svc.Close()
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestService_Close_FlushesClicks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	stMock := storagemock.NewMockStorage(mockCtrl)
+	svc := newClosableService(t, stMock, time.Second)
+
+	input := []model.Click{
+		{URLID: 1, Referrer: "https://referrer.com/"},
+		{URLID: 2, IP: "127.0.0.1"},
+		{URLID: 1, UserAgent: "curl/7.68.0"},
+	}
+
+	var recorded []model.Click
+	stMock.EXPECT().
+		AddClicks(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, objs []model.Click) {
+			recorded = append(recorded, objs...)
+		}).
+		Return(nil).
+		AnyTimes()
+	stMock.EXPECT().
+		Close().
+		DoAndReturn(func() error {
+			assert.Len(t, recorded, len(input), "clicks are persisted before storage is closed")
+			return nil
+		})
+
+	for _, click := range input {
+		require.NoError(t, svc.AddClick(context.TODO(), click))
+	}
+	require.NoError(t, svc.Close())
+
+	require.Len(t, recorded, len(input))
+	for idx := range input {
+		assert.Equal(t, input[idx].URLID, recorded[idx].URLID)
+	}
+
+	err := svc.AddClick(context.TODO(), input[0])
+	assert.True(t, errors.Is(err, pkg.ErrServiceClosed))
+}
+
+func TestService_Close_StopsExpiration(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	stMock := storagemock.NewMockStorage(mockCtrl)
+
+	config := NewDefaultConfig()
+	config.DelReqTimeout = time.Minute
+	config.ExpReapInterval = time.Second
+
+	reaping := make(chan struct{})
+	gomock.InOrder(
+		stMock.EXPECT().
+			RemoveExpiredURLs(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, now time.Time) (int, error) {
+				close(reaping)
+				<-ctx.Done()
+				return 0, ctx.Err()
+			}),
+		stMock.EXPECT().
+			Close().
+			Return(nil),
+	)
+
+	// expectations are set before the reaper starts
+	svc, err := NewService(
+		WithConfig(config),
+		WithStorage(stMock),
+	)
+	require.NoError(t, err)
+
+	<-reaping
+	start := time.Now()
+	require.NoError(t, svc.Close())
+	assert.Less(t, time.Since(start), config.DelReqTimeout, "reaping in progress is cancelled")
+}
+
+func TestService_RetryDeletions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	stMock := storagemock.NewMockStorage(mockCtrl)
+	svc := newClosableService(t, stMock, time.Second)
+	config := svc.config
+	config.DelBufCap = 2
+	config.DelMaxAttempts = 3
+
+	userID := uuid.New()
+	now := time.Now()
+	dels := []model.Deletion{
+		{ID: 1, UserID: userID, Code: "a1B2c3D", Attempts: 0},
+		{ID: 2, UserID: userID, Code: "e4F5g6H", Attempts: 2},
+		{ID: 3, UserID: userID, Code: "i7J8k9L", Attempts: 1},
+	}
+	delErr := errors.New("connection refused")
+
+	gomock.InOrder(
+		stMock.EXPECT().
+			RemoveDoneDeletions(gomock.Any(), now.Add(-config.DelJobRetention)).
+			Return(1, nil),
+		stMock.EXPECT().
+			ClaimDueDeletions(gomock.Any(), now, 2, delLease(config)).
+			Return(dels[:2], nil),
+		stMock.EXPECT().
+			RemoveUsersURLs(gomock.Any(), []model.URL{
+				{Code: "a1B2c3D", UserID: userID},
+				{Code: "e4F5g6H", UserID: userID},
+			}).
+			Return(nil, delErr),
+		stMock.EXPECT().
+			UpdateDeletions(gomock.Any(), gomock.Any()).
+			Do(func(ctx context.Context, objs []model.Deletion) {
+				require.Len(t, objs, 2)
+
+				assert.Equal(t, 1, objs[0].Attempts)
+				assert.Equal(t, delErr.Error(), objs[0].LastError)
+				assert.False(t, objs[0].IsDead())
+				assert.True(t, objs[0].NextAttemptAt.After(now))
+
+				assert.Equal(t, 3, objs[1].Attempts)
+				assert.True(t, objs[1].IsDead())
+			}).
+			Return(nil),
+		stMock.EXPECT().
+			ClaimDueDeletions(gomock.Any(), now, 2, delLease(config)).
+			Return(dels[2:], nil),
+		stMock.EXPECT().
+			RemoveUsersURLs(gomock.Any(), []model.URL{{Code: "i7J8k9L", UserID: userID}}).
+			Return([]model.DeletionStatus{model.DeletionNotOwned}, nil),
+		stMock.EXPECT().
+			UpdateDeletions(gomock.Any(), gomock.Any()).
+			Do(func(ctx context.Context, objs []model.Deletion) {
+				require.Len(t, objs, 1)
+				assert.Equal(t, model.DeletionNotOwned, objs[0].Status)
+				assert.False(t, objs[0].DoneAt.IsZero())
+			}).
+			Return(nil),
+	)
+
+	svc.retryDeletions(config, now)
+
+	assert.Equal(t, 0, dels[0].Attempts, "loaded deletions are not modified")
+}
+
+func TestDelBackoff(t *testing.T) {
+	config := NewDefaultConfig()
+	config.DelRetryBackoff = time.Second
+	config.DelRetryMaxBackoff = 10 * time.Second
+
+	assert.Equal(t, time.Second, delBackoff(config, 1))
+	assert.Equal(t, 2*time.Second, delBackoff(config, 2))
+	assert.Equal(t, 8*time.Second, delBackoff(config, 4))
+	assert.Equal(t, 10*time.Second, delBackoff(config, 5))
+	assert.Equal(t, 10*time.Second, delBackoff(config, 100))
+}
@@ -0,0 +1,100 @@
+package shortener
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
+	"github.com/vstdy/go-shortener/pkg/tracing"
+)
+
+const (
+	statsDefaultRange = 7 * 24 * time.Hour
+	statsMaxBuckets   = 24 * 31
+	statsTopLimit     = 10
+)
+
+// GetURLStats gets clicks statistics of current user object with given short code.
+func (svc *Service) GetURLStats(
+	ctx context.Context, userID uuid.UUID, code string, params model.StatsParams) (
+	stats model.URLStats, err error) {
+
+	ctx, span := tracing.StartSpanFromCtx(ctx, "shortener GetURLStats")
+	defer tracing.FinishSpan(span, err)
+
+	if err = shortcode.Validate(code); err != nil {
+		return model.URLStats{}, fmt.Errorf("shortener: GetURLStats: %w: code: %v", pkg.ErrInvalidInput, err)
+	}
+
+	if err = resolveStatsParams(&params, time.Now()); err != nil {
+		return model.URLStats{}, fmt.Errorf("shortener: GetURLStats: %w: %v", pkg.ErrInvalidInput, err)
+	}
+
+	obj, err := svc.storage.GetURL(ctx, code)
+	if err != nil {
+		return model.URLStats{}, fmt.Errorf("shortener: GetURLStats: %w", err)
+	}
+	if obj.URL == "" {
+		return model.URLStats{}, fmt.Errorf("shortener: GetURLStats: %w", pkg.ErrNotFound)
+	}
+	if obj.UserID != userID {
+		return model.URLStats{}, fmt.Errorf("shortener: GetURLStats: %w", pkg.ErrForbidden)
+	}
+
+	stats, err = svc.storage.GetClickStats(ctx, obj.ID, params, statsTopLimit)
+	if err != nil {
+		return model.URLStats{}, fmt.Errorf("shortener: GetURLStats: %w", err)
+	}
+	stats.Buckets = fillBuckets(stats.Buckets, params)
+
+	return stats, nil
+}
+
+// resolveStatsParams sets default statistics params and validates them.
+// The time range defaults to the last week, buckets default to days.
+func resolveStatsParams(params *model.StatsParams, now time.Time) error {
+	if params.To.IsZero() {
+		params.To = now
+	}
+	if params.From.IsZero() {
+		params.From = params.To.Add(-statsDefaultRange)
+	}
+	if params.Bucket == 0 {
+		params.Bucket = 24 * time.Hour
+	}
+
+	if params.Bucket != time.Hour && params.Bucket != 24*time.Hour {
+		return fmt.Errorf("bucket: must be an hour or a day")
+	}
+
+	if !params.From.Before(params.To) {
+		return fmt.Errorf("from: must be before to")
+	}
+
+	if params.To.Sub(params.From)/params.Bucket > statsMaxBuckets {
+		return fmt.Errorf("time range: too many buckets, %d at most", statsMaxBuckets)
+	}
+
+	return nil
+}
+
+// fillBuckets returns buckets covering the whole time range, buckets without clicks are added empty.
+// Buckets are aligned to UTC.
+func fillBuckets(buckets []model.StatsBucket, params model.StatsParams) []model.StatsBucket {
+	clicks := make(map[time.Time]int, len(buckets))
+	for _, bucket := range buckets {
+		clicks[bucket.Start.UTC()] += bucket.Clicks
+	}
+
+	var filled []model.StatsBucket
+	for start := params.From.UTC().Truncate(params.Bucket); start.Before(params.To); start = start.Add(params.Bucket) {
+		filled = append(filled, model.StatsBucket{Start: start, Clicks: clicks[start]})
+	}
+
+	return filled
+}
@@ -0,0 +1,165 @@
+package shortener
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	storageMock "github.com/vstdy/go-shortener/storage/mock"
+)
+
+func (s *TestSuite) TestService_GetURLStats() {
+	type input struct {
+		userID uuid.UUID
+		code   string
+		params model.StatsParams
+	}
+
+	type testCase struct {
+		name         string
+		prepareMocks func(StorageMock *storageMock.MockStorage) input
+		expected     model.URLStats
+		errExpected  bool
+		errTarget    error
+		errContains  string
+	}
+
+	userID := uuid.New()
+	from := time.Date(2022, 2, 15, 0, 0, 0, 0, time.UTC)
+	to := from.Add(3 * time.Hour)
+	url := model.URL{
+		ID:     1,
+		Code:   "a1B2c3D",
+		UserID: userID,
+		URL:    "https://lengthy-url.com/",
+	}
+
+	testCases := []testCase{
+		{
+			name: "Fail: invalid input (unsupported bucket)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) input {
+				return input{
+					userID: userID,
+					code:   url.Code,
+					params: model.StatsParams{Bucket: time.Minute},
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "bucket",
+		},
+		{
+			name: "Fail: invalid input (too many buckets)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) input {
+				return input{
+					userID: userID,
+					code:   url.Code,
+					params: model.StatsParams{From: from, To: from.AddDate(1, 0, 0), Bucket: time.Hour},
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "time range",
+		},
+		{
+			name: "Fail: url not found",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) input {
+				StorageMock.EXPECT().
+					GetURL(gomock.Any(), "e4F5g6H").
+					Return(model.URL{}, nil)
+
+				return input{userID: userID, code: "e4F5g6H"}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrNotFound,
+		},
+		{
+			name: "Fail: url of another user",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) input {
+				StorageMock.EXPECT().
+					GetURL(gomock.Any(), url.Code).
+					Return(url, nil)
+
+				return input{userID: uuid.New(), code: url.Code}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrForbidden,
+		},
+		{
+			name: "OK",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) input {
+				StorageMock.EXPECT().
+					GetURL(gomock.Any(), url.Code).
+					Return(url, nil)
+
+				StorageMock.EXPECT().
+					GetClickStats(gomock.Any(), url.ID, model.StatsParams{From: from, To: to, Bucket: time.Hour}, statsTopLimit).
+					Return(model.URLStats{
+						TotalClicks:    4,
+						UniqueVisitors: 3,
+						Buckets: []model.StatsBucket{
+							{Start: from, Clicks: 2},
+							{Start: from.Add(2 * time.Hour), Clicks: 2},
+						},
+						TopReferrers: []model.StatsCounter{
+							{Value: "https://b.com/", Clicks: 2},
+							{Value: "https://a.com/", Clicks: 1},
+						},
+						TopUserAgents: []model.StatsCounter{
+							{Value: "Mozilla/5.0", Clicks: 2},
+							{Value: "curl", Clicks: 2},
+						},
+					}, nil)
+
+				return input{
+					userID: userID,
+					code:   url.Code,
+					params: model.StatsParams{From: from, To: to, Bucket: time.Hour},
+				}
+			},
+			expected: model.URLStats{
+				TotalClicks:    4,
+				UniqueVisitors: 3,
+				Buckets: []model.StatsBucket{
+					{Start: from, Clicks: 2},
+					{Start: from.Add(time.Hour), Clicks: 0},
+					{Start: from.Add(2 * time.Hour), Clicks: 2},
+				},
+				TopReferrers: []model.StatsCounter{
+					{Value: "https://b.com/", Clicks: 2},
+					{Value: "https://a.com/", Clicks: 1},
+				},
+				TopUserAgents: []model.StatsCounter{
+					{Value: "Mozilla/5.0", Clicks: 2},
+					{Value: "curl", Clicks: 2},
+				},
+			},
+			errExpected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := tc.prepareMocks(s.stMock)
+
+			stats, err := s.svc.GetURLStats(s.ctx, in.userID, in.code, in.params)
+			if tc.errExpected {
+				s.Assert().Error(err)
+				if tc.errTarget != nil {
+					s.Assert().True(errors.Is(err, tc.errTarget))
+				}
+				if tc.errContains != "" {
+					s.Assert().Contains(err.Error(), tc.errContains)
+				}
+				return
+			}
+
+			s.Assert().NoError(err)
+			s.Assert().Equal(tc.expected, stats)
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
 	storagemock "github.com/vstdy/go-shortener/storage/mock"
 )
 
@@ -27,9 +29,31 @@ func (s *TestSuite) SetupSuite() {
 	urlStorageMock := storagemock.NewMockStorage(mockCtrl)
 
 	config := Config{
-		DelReqTimeout:     5 * time.Second,
-		DelBufWipeTimeout: time.Second,
-		DelBufCap:         2,
+		DelReqTimeout:       5 * time.Second,
+		DelBufWipeTimeout:   time.Second,
+		DelBufCap:           2,
+		DelDrainTimeout:     5 * time.Second,
+		DelRetryInterval:    time.Hour,
+		DelRetryBackoff:     time.Second,
+		DelRetryMaxBackoff:  time.Minute,
+		DelMaxAttempts:      3,
+		DelJobRetention:     time.Hour,
+		CodeAlphabet:        shortcode.DefaultAlphabet,
+		CodeLength:          7,
+		CodeGenAttempts:     2,
+		ExpReapInterval:     time.Hour,
+		ClickReqTimeout:     5 * time.Second,
+		ClickBufWipeTimeout: time.Second,
+		ClickBufCap:         2,
+		ClickQueueCap:       10,
+		RestoreGracePeriod:  72 * time.Hour,
+		DedupScope:          model.DedupScopeGlobal,
+		BaseURL:             "http://127.0.0.1:8080",
+		URLSchemes:          []string{"http", "https"},
+		URLMaxLength:        2048,
+		URLBlockedHosts:     []string{"blocked.example"},
+		URLRejectPrivate:    true,
+		URLStripParams:      []string{"utm_*", "fbclid"},
 	}
 
 	svc, err := NewService(
@@ -43,6 +67,11 @@ func (s *TestSuite) SetupSuite() {
 	s.ctx = context.TODO()
 }
 
+func (s *TestSuite) TearDownSuite() {
+	s.stMock.EXPECT().Close().Return(nil)
+	s.Require().NoError(s.svc.Close())
+}
+
 func TestSuite_Service(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -0,0 +1,55 @@
+package shortener
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
+	"github.com/vstdy/go-shortener/pkg/tracing"
+)
+
+// GetUsersDeletedURLs gets current user objects deleted within restore grace period.
+func (svc *Service) GetUsersDeletedURLs(ctx context.Context, userID uuid.UUID) (objs []model.URL, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "shortener GetUsersDeletedURLs")
+	defer tracing.FinishSpan(span, err)
+
+	objs, err = svc.storage.GetUsersDeletedURLs(ctx, userID, svc.restoreSince())
+	if err != nil {
+		return nil, fmt.Errorf("shortener: GetUsersDeletedURLs: %w", err)
+	}
+
+	return objs, nil
+}
+
+// RestoreURL restores current user object with given short code deleted within restore grace period.
+// Restore fails with ErrAlreadyExists when the url has been shortened again since deletion.
+func (svc *Service) RestoreURL(ctx context.Context, obj *model.URL) (err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "shortener RestoreURL")
+	defer tracing.FinishSpan(span, err)
+
+	if err = shortcode.Validate(obj.Code); err != nil {
+		return fmt.Errorf("shortener: RestoreURL: %w: code: %v", pkg.ErrInvalidInput, err)
+	}
+
+	restoredObj, err := svc.storage.RestoreURL(ctx, *obj, svc.restoreSince())
+	if err != nil {
+		return fmt.Errorf("shortener: RestoreURL: %w", err)
+	}
+	if restoredObj.URL == "" {
+		return fmt.Errorf("shortener: RestoreURL: %w", pkg.ErrNotFound)
+	}
+
+	*obj = restoredObj
+
+	return nil
+}
+
+// restoreSince returns the earliest deletion time of a restorable object.
+func (svc *Service) restoreSince() time.Time {
+	return time.Now().Add(-svc.config.RestoreGracePeriod)
+}
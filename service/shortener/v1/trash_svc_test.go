@@ -0,0 +1,138 @@
+package shortener
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	storageMock "github.com/vstdy/go-shortener/storage/mock"
+)
+
+func (s *TestSuite) TestService_GetUsersDeletedURLs() {
+	userID := uuid.New()
+	deletedObjs := []model.URL{
+		{
+			ID:        1,
+			Code:      "a1B2c3D",
+			UserID:    userID,
+			URL:       "https://lengthy-url.com/",
+			DeletedAt: time.Now().Add(-time.Hour),
+		},
+	}
+
+	s.stMock.EXPECT().
+		GetUsersDeletedURLs(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, since time.Time) ([]model.URL, error) {
+			s.Assert().WithinDuration(time.Now().Add(-72*time.Hour), since, time.Minute)
+			return deletedObjs, nil
+		})
+
+	objs, err := s.svc.GetUsersDeletedURLs(s.ctx, userID)
+	s.Require().NoError(err)
+	s.Assert().Equal(deletedObjs, objs)
+}
+
+func (s *TestSuite) TestService_RestoreURL() {
+	type testCase struct {
+		name         string
+		prepareMocks func(StorageMock *storageMock.MockStorage) model.URL
+		errExpected  bool
+		errTarget    error
+		errContains  string
+	}
+
+	testCases := []testCase{
+		{
+			name: "Fail: invalid input (invalid code)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					Code:   "a1B2c3D!",
+					UserID: uuid.New(),
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "code",
+		},
+		{
+			name: "Fail: url not in trash",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: uuid.New(),
+				}
+
+				StorageMock.EXPECT().
+					RestoreURL(gomock.Any(), input, gomock.Any()).
+					Return(model.URL{}, nil)
+
+				return input
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrNotFound,
+		},
+		{
+			name: "Fail: url is shortened again",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: uuid.New(),
+				}
+
+				StorageMock.EXPECT().
+					RestoreURL(gomock.Any(), input, gomock.Any()).
+					Return(model.URL{}, pkg.ErrAlreadyExists)
+
+				return input
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrAlreadyExists,
+		},
+		{
+			name: "OK",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: uuid.New(),
+				}
+
+				restoredObj := input
+				restoredObj.ID = 1
+				restoredObj.URL = "https://lengthy-url.com/"
+				StorageMock.EXPECT().
+					RestoreURL(gomock.Any(), input, gomock.Any()).
+					Return(restoredObj, nil)
+
+				return input
+			},
+			errExpected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			input := tc.prepareMocks(s.stMock)
+
+			err := s.svc.RestoreURL(s.ctx, &input)
+			if tc.errExpected {
+				s.Assert().Error(err)
+				if tc.errTarget != nil {
+					s.Assert().True(errors.Is(err, tc.errTarget))
+				}
+				if tc.errContains != "" {
+					s.Assert().Contains(err.Error(), tc.errContains)
+				}
+				return
+			}
+
+			s.Assert().NoError(err)
+			s.Assert().Equal(1, input.ID)
+			s.Assert().Equal("https://lengthy-url.com/", input.URL)
+		})
+	}
+}
@@ -4,33 +4,52 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/vstdy/go-shortener/model"
 	"github.com/vstdy/go-shortener/pkg"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
 	"github.com/vstdy/go-shortener/pkg/tracing"
-	"github.com/vstdy/go-shortener/service/shortener/v1/validator"
+	"github.com/vstdy/go-shortener/pkg/validator"
 )
 
 // AddURL adds given object to storage.
+// Object short code is used as a custom alias when set.
 func (svc *Service) AddURL(ctx context.Context, obj *model.URL) (err error) {
 	ctx, span := tracing.StartSpanFromCtx(ctx, "shortener AddURL")
 	defer tracing.FinishSpan(span, err)
 
-	if err = validator.ValidateURL(obj.URL); err != nil {
-		return fmt.Errorf("%w: url: %v", pkg.ErrInvalidInput, err)
+	if err = svc.prepareURL("url", obj); err != nil {
+		return fmt.Errorf("shortener: AddURL: %w", err)
+	}
+
+	if obj.Code != "" {
+		if err = validator.ValidateAlias(obj.Code); err != nil {
+			return fmt.Errorf("shortener: AddURL: %w: alias: %v", pkg.ErrInvalidInput, err)
+		}
 	}
 
-	objs, err := svc.storage.AddURLs(ctx, []model.URL{*obj})
+	if err = validateOptions(obj, time.Now()); err != nil {
+		return fmt.Errorf("shortener: AddURL: %w: %v", pkg.ErrInvalidInput, err)
+	}
+
+	objs, err := svc.addURLs(ctx, []model.URL{*obj})
 	if err != nil {
+		if errors.Is(err, pkg.ErrCodeTaken) && obj.Code != "" {
+			return fmt.Errorf("shortener: AddURL: %w: alias: %s", pkg.ErrCodeTaken, obj.Code)
+		}
 		if errors.Is(err, pkg.ErrAlreadyExists) {
 			obj.ID = objs[0].ID
+			obj.Code = objs[0].Code
 		}
 		return fmt.Errorf("shortener: AddURL: %w", err)
 	}
 
 	obj.ID = objs[0].ID
+	obj.Code = objs[0].Code
 
 	return nil
 }
@@ -41,19 +60,29 @@ func (svc *Service) AddURLsBatch(ctx context.Context, objs *[]model.URL) (err er
 	defer tracing.FinishSpan(span, err)
 
 	if *objs == nil {
-		return pkg.ErrInvalidInput
+		return fmt.Errorf("shortener: AddURLsBatch: %w: urls: empty", pkg.ErrInvalidInput)
 	}
 
-	for _, obj := range *objs {
-		if err = validator.ValidateURL(obj.URL); err != nil {
-			return fmt.Errorf("shortener: %w: url: %v", pkg.ErrInvalidInput, err)
+	now := time.Now()
+	for idx := range *objs {
+		obj := &(*objs)[idx]
+		if err = svc.prepareURL(fmt.Sprintf("urls[%d].url", idx), obj); err != nil {
+			return fmt.Errorf("shortener: AddURLsBatch: %w", err)
 		}
 		if obj.CorrelationID == "" {
-			return fmt.Errorf("shortener: %w: correlation_id: empty", pkg.ErrInvalidInput)
+			return fmt.Errorf("shortener: AddURLsBatch: %w: correlation_id: empty", pkg.ErrInvalidInput)
+		}
+		if obj.Code != "" {
+			if err = validator.ValidateAlias(obj.Code); err != nil {
+				return fmt.Errorf("shortener: AddURLsBatch: %w: alias: %v", pkg.ErrInvalidInput, err)
+			}
+		}
+		if err = validateOptions(obj, now); err != nil {
+			return fmt.Errorf("shortener: AddURLsBatch: %w: %v", pkg.ErrInvalidInput, err)
 		}
 	}
 
-	addedObjs, err := svc.storage.AddURLs(ctx, *objs)
+	addedObjs, err := svc.addURLs(ctx, *objs)
 	if err != nil {
 		if errors.Is(err, pkg.ErrAlreadyExists) {
 			*objs = addedObjs
@@ -66,54 +95,285 @@ func (svc *Service) AddURLsBatch(ctx context.Context, objs *[]model.URL) (err er
 	return nil
 }
 
-// GetURL gets object with given id.
-func (svc *Service) GetURL(ctx context.Context, id int) (url string, err error) {
+// GetURL gets object with given short code.
+// Gone objects are returned empty, redirect code defaults to 307 Temporary Redirect.
+// A click of click-limited object is redeemed, objects out of clicks are treated as gone.
+func (svc *Service) GetURL(ctx context.Context, code string) (obj model.URL, err error) {
 	ctx, span := tracing.StartSpanFromCtx(ctx, "shortener GetURL")
 	defer tracing.FinishSpan(span, err)
 
-	if id < 1 {
-		return "", fmt.Errorf("shortener: GetURL: %w: id: less than 1", pkg.ErrInvalidInput)
+	if err = shortcode.Validate(code); err != nil {
+		return model.URL{}, fmt.Errorf("shortener: GetURL: %w: code: %v", pkg.ErrInvalidInput, err)
 	}
 
-	urlModel, err := svc.storage.GetURL(ctx, id)
+	obj, err = svc.storage.GetURL(ctx, code)
 	if err != nil {
-		return "", fmt.Errorf("shortener: GetURL: %w", err)
+		return model.URL{}, fmt.Errorf("shortener: GetURL: %w", err)
+	}
+
+	if obj.URL == "" || isExpired(obj, time.Now()) {
+		return model.URL{}, nil
 	}
 
-	return urlModel.URL, nil
+	if obj.MaxClicks > 0 {
+		obj, err = svc.storage.RedeemURL(ctx, code)
+		if err != nil {
+			return model.URL{}, fmt.Errorf("shortener: GetURL: %w", err)
+		}
+		if obj.URL == "" {
+			return model.URL{}, nil
+		}
+	}
+
+	if obj.RedirectCode == 0 {
+		obj.RedirectCode = http.StatusTemporaryRedirect
+	}
+
+	return obj, nil
 }
 
-// GetUsersURLs gets current user objects.
-func (svc *Service) GetUsersURLs(ctx context.Context, userID uuid.UUID) (objs []model.URL, err error) {
+// GetUsersURLs gets a page of current user objects matching list filter in list order.
+func (svc *Service) GetUsersURLs(
+	ctx context.Context, userID uuid.UUID, params model.ListParams) (
+	page model.URLsPage, err error) {
+
 	ctx, span := tracing.StartSpanFromCtx(ctx, "shortener GetUsersURLs")
 	defer tracing.FinishSpan(span, err)
 
-	objs, err = svc.storage.GetUsersURLs(ctx, userID)
+	query, err := resolveListParams(&params)
+	if err != nil {
+		return model.URLsPage{}, fmt.Errorf("shortener: GetUsersURLs: %w: %v", pkg.ErrInvalidInput, err)
+	}
+
+	// one extra object is fetched to find out whether the next page exists
+	query.Limit++
+	objs, err := svc.storage.GetUsersURLs(ctx, userID, query)
 	if err != nil {
-		return nil, fmt.Errorf("shortener: GetUsersURLs: %w", err)
+		return model.URLsPage{}, fmt.Errorf("shortener: GetUsersURLs: %w", err)
 	}
 
-	return objs, nil
+	if len(objs) > params.Limit {
+		objs = objs[:params.Limit]
+		page.NextCursor = encodeCursor(objs[len(objs)-1], params.Sort, params.Desc)
+	}
+	page.URLs = objs
+
+	return page, nil
 }
 
-// RemoveUsersURLs removes current user objects with given ids.
-func (svc *Service) RemoveUsersURLs(ctx context.Context, objs []model.URL) (err error) {
+// UpdateURL updates url and redirect code of current user object with given short code.
+// Zero fields are left unchanged.
+func (svc *Service) UpdateURL(ctx context.Context, obj *model.URL) (err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "shortener UpdateURL")
+	defer tracing.FinishSpan(span, err)
+
+	if err = shortcode.Validate(obj.Code); err != nil {
+		return fmt.Errorf("shortener: UpdateURL: %w: code: %v", pkg.ErrInvalidInput, err)
+	}
+
+	if obj.URL == "" && obj.RedirectCode == 0 {
+		return fmt.Errorf("shortener: UpdateURL: %w: nothing to update", pkg.ErrInvalidInput)
+	}
+
+	if obj.URL != "" {
+		if err = svc.prepareURL("url", obj); err != nil {
+			return fmt.Errorf("shortener: UpdateURL: %w", err)
+		}
+	}
+
+	if obj.RedirectCode != 0 {
+		if err = validator.ValidateRedirectCode(obj.RedirectCode); err != nil {
+			return fmt.Errorf("shortener: UpdateURL: %w: redirect_code: %v", pkg.ErrInvalidInput, err)
+		}
+	}
+
+	updatedObj, err := svc.storage.UpdateURL(ctx, *obj)
+	if err != nil {
+		return fmt.Errorf("shortener: UpdateURL: %w", err)
+	}
+	if updatedObj.URL == "" {
+		return fmt.Errorf("shortener: UpdateURL: %w", pkg.ErrNotFound)
+	}
+
+	*obj = updatedObj
+
+	return nil
+}
+
+// RemoveUsersURLs removes current user objects with given short codes.
+// Objects are removed asynchronously, returns the id of the deletion job tracking their removal.
+func (svc *Service) RemoveUsersURLs(ctx context.Context, objs []model.URL) (jobID uuid.UUID, err error) {
 	_, span := tracing.StartSpanFromCtx(ctx, "shortener RemoveUsersURLs")
 	defer tracing.FinishSpan(span, err)
 
 	if len(objs) == 0 {
-		return fmt.Errorf("shortener: RemoveUsersURLs: %w: ids: empty", pkg.ErrInvalidInput)
+		return uuid.Nil, fmt.Errorf("shortener: RemoveUsersURLs: %w: ids: empty", pkg.ErrInvalidInput)
+	}
+
+	for _, obj := range objs {
+		if err = shortcode.Validate(obj.Code); err != nil {
+			return uuid.Nil, fmt.Errorf("shortener: RemoveUsersURLs: %w: ids: %v", pkg.ErrInvalidInput, err)
+		}
+	}
+
+	svc.RLock()
+	defer svc.RUnlock()
+
+	if svc.closed {
+		return uuid.Nil, fmt.Errorf("shortener: RemoveUsersURLs: %w", pkg.ErrServiceClosed)
+	}
+
+	// deletions are persisted before being accepted, so they survive restarts and storage failures
+	now := time.Now()
+	jobID = uuid.New()
+	dels := make([]model.Deletion, 0, len(objs))
+	for _, obj := range objs {
+		dels = append(dels, model.Deletion{
+			JobID:         jobID,
+			UserID:        obj.UserID,
+			Code:          obj.Code,
+			Status:        model.DeletionPending,
+			NextAttemptAt: now.Add(delLease(svc.config)),
+			CreatedAt:     now,
+		})
+	}
+
+	dels, err = svc.storage.AddDeletions(ctx, dels)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("shortener: RemoveUsersURLs: %w", err)
 	}
 
+	svc.delSenders.Add(1)
 	go func() {
-		for _, obj := range objs {
-			svc.delChan <- obj
+		defer svc.delSenders.Done()
+
+		for _, del := range dels {
+			svc.delChan <- del
 		}
 	}()
 
+	return jobID, nil
+}
+
+// prepareURL converts object url to canonical form and validates it against configured policy.
+// The url is kept as given for display, so its length is limited as well.
+// Violations are returned as *pkg.InputError of given field.
+func (svc *Service) prepareURL(field string, obj *model.URL) error {
+	if err := svc.urlPolicy.ValidateLength(obj.URL); err != nil {
+		return &pkg.InputError{Field: field, Reason: validator.ReasonTooLong, Message: err.Error()}
+	}
+
+	canonicalURL, err := svc.urlCanon.Canonicalize(obj.URL)
+	if err != nil {
+		return &pkg.InputError{Field: field, Reason: validator.ReasonMalformedURL, Message: err.Error()}
+	}
+
+	if err = svc.validateURL(field, canonicalURL); err != nil {
+		return err
+	}
+
+	obj.InputURL = obj.URL
+	obj.URL = canonicalURL
+
 	return nil
 }
 
+// validateURL validates url against configured policy.
+// Violations are returned as *pkg.InputError of given field.
+func (svc *Service) validateURL(field, urlRaw string) error {
+	err := svc.urlPolicy.Validate(urlRaw)
+	if err == nil {
+		return nil
+	}
+
+	inputErr := &pkg.InputError{Field: field, Reason: validator.ReasonMalformedURL, Message: err.Error()}
+	var violation *validator.URLViolation
+	if errors.As(err, &violation) {
+		inputErr.Reason = violation.Reason
+	}
+
+	return inputErr
+}
+
+// addURLs assigns random short codes to given objects without alias and adds them to storage.
+// Short codes are regenerated on collision up to configured number of attempts,
+// a batch containing aliases is not retried.
+func (svc *Service) addURLs(ctx context.Context, objs []model.URL) ([]model.URL, error) {
+	objs = append([]model.URL(nil), objs...)
+
+	hasAliases := false
+	aliased := make([]bool, len(objs))
+	for idx := range objs {
+		aliased[idx] = objs[idx].Code != ""
+		hasAliases = hasAliases || aliased[idx]
+	}
+
+	for attempt := 1; ; attempt++ {
+		for idx := range objs {
+			if aliased[idx] {
+				continue
+			}
+
+			code, err := svc.codeGen.Generate()
+			if err != nil {
+				return nil, err
+			}
+			objs[idx].Code = code
+		}
+
+		addedObjs, err := svc.storage.AddURLs(ctx, objs, svc.config.DedupScope)
+		if errors.Is(err, pkg.ErrCodeTaken) && !hasAliases && attempt < svc.config.CodeGenAttempts {
+			continue
+		}
+
+		return addedObjs, err
+	}
+}
+
+// validateOptions validates optional object settings.
+func validateOptions(obj *model.URL, now time.Time) error {
+	if err := resolveExpiration(obj, now); err != nil {
+		return err
+	}
+
+	if obj.MaxClicks < 0 {
+		return fmt.Errorf("max_clicks: negative value")
+	}
+
+	if obj.RedirectCode != 0 {
+		if err := validator.ValidateRedirectCode(obj.RedirectCode); err != nil {
+			return fmt.Errorf("redirect_code: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// resolveExpiration converts object ttl to expiration time and validates it.
+func resolveExpiration(obj *model.URL, now time.Time) error {
+	if obj.TTL < 0 {
+		return fmt.Errorf("ttl: negative value")
+	}
+
+	if obj.TTL > 0 {
+		if !obj.ExpiresAt.IsZero() {
+			return fmt.Errorf("ttl and expires_at are mutually exclusive")
+		}
+		obj.ExpiresAt = now.Add(obj.TTL)
+	}
+
+	if isExpired(*obj, now) {
+		return fmt.Errorf("expires_at: must be in the future")
+	}
+
+	return nil
+}
+
+// isExpired checks whether the object is expired by given time.
+func isExpired(obj model.URL, now time.Time) bool {
+	return !obj.ExpiresAt.IsZero() && !obj.ExpiresAt.After(now)
+}
+
 // Ping verifies a connection to the database is still alive.
 func (svc *Service) Ping() error {
 	if err := svc.storage.Ping(); err != nil {
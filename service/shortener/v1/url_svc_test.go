@@ -3,12 +3,16 @@ package shortener
 import (
 	"context"
 	"errors"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 
 	"github.com/vstdy/go-shortener/model"
 	"github.com/vstdy/go-shortener/pkg"
+	"github.com/vstdy/go-shortener/pkg/validator"
 	storageMock "github.com/vstdy/go-shortener/storage/mock"
 )
 
@@ -47,24 +51,224 @@ func (s *TestSuite) TestService_AddURL() {
 			errContains: "url",
 		},
 		{
-			name: "OK",
+			name: "Fail: invalid input (reserved alias)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					Code:   "api",
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "alias",
+		},
+		{
+			name: "Fail: invalid input (digits only alias)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					Code:   "2022",
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "alias",
+		},
+		{
+			name: "Fail: alias is taken",
 			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
 				input := model.URL{
+					Code:   "spring-sale",
 					UserID: uuid.New(),
 					URL:    "https://lengthy-url.com/",
 				}
 
-				urls := []model.URL{
-					{
-						ID:     1,
-						UserID: input.UserID,
-						URL:    input.URL,
-					},
+				stored := input
+				stored.InputURL = input.URL
+				StorageMock.EXPECT().
+					AddURLs(gomock.Any(), []model.URL{stored}, model.DedupScopeGlobal).
+					Return(nil, pkg.ErrCodeTaken)
+
+				return input
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrCodeTaken,
+			errContains: "spring-sale",
+		},
+		{
+			name: "OK: alias",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					Code:   "spring-sale",
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
 				}
 
+				stored := input
+				stored.InputURL = input.URL
 				StorageMock.EXPECT().
-					AddURLs(gomock.Any(), []model.URL{input}).
-					Return(urls, nil)
+					AddURLs(gomock.Any(), []model.URL{stored}, model.DedupScopeGlobal).
+					DoAndReturn(func(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
+						objs[0].ID = 1
+						return objs, nil
+					})
+
+				return input
+			},
+			errExpected: false,
+		},
+		{
+			name: "Fail: invalid input (negative ttl)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+					TTL:    -time.Hour,
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "ttl",
+		},
+		{
+			name: "Fail: invalid input (both ttl and expires_at)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					UserID:    uuid.New(),
+					URL:       "https://lengthy-url.com/",
+					ExpiresAt: time.Now().Add(time.Hour),
+					TTL:       time.Hour,
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "mutually exclusive",
+		},
+		{
+			name: "Fail: invalid input (expires_at in the past)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					UserID:    uuid.New(),
+					URL:       "https://lengthy-url.com/",
+					ExpiresAt: time.Now().Add(-time.Hour),
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "expires_at",
+		},
+		{
+			name: "Fail: invalid input (negative max_clicks)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					UserID:    uuid.New(),
+					URL:       "https://lengthy-url.com/",
+					MaxClicks: -1,
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "max_clicks",
+		},
+		{
+			name: "Fail: invalid input (unsupported redirect_code)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					UserID:       uuid.New(),
+					URL:          "https://lengthy-url.com/",
+					RedirectCode: http.StatusOK,
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "redirect_code",
+		},
+		{
+			name: "OK: ttl",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+					TTL:    time.Hour,
+				}
+
+				StorageMock.EXPECT().
+					AddURLs(gomock.Any(), gomock.Any(), model.DedupScopeGlobal).
+					DoAndReturn(func(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
+						s.Require().Len(objs, 1)
+						s.Assert().WithinDuration(time.Now().Add(time.Hour), objs[0].ExpiresAt, time.Minute)
+
+						objs[0].ID = 1
+						return objs, nil
+					})
+
+				return input
+			},
+			errExpected: false,
+		},
+		{
+			name: "Fail: short code collisions limit exceeded",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+				}
+
+				StorageMock.EXPECT().
+					AddURLs(gomock.Any(), gomock.Any(), model.DedupScopeGlobal).
+					Return(nil, pkg.ErrCodeTaken).
+					Times(2)
+
+				return input
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrCodeTaken,
+		},
+		{
+			name: "OK: short code collision",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+				}
+
+				gomock.InOrder(
+					StorageMock.EXPECT().
+						AddURLs(gomock.Any(), gomock.Any(), model.DedupScopeGlobal).
+						Return(nil, pkg.ErrCodeTaken),
+					StorageMock.EXPECT().
+						AddURLs(gomock.Any(), gomock.Any(), model.DedupScopeGlobal).
+						DoAndReturn(func(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
+							objs[0].ID = 1
+							return objs, nil
+						}),
+				)
+
+				return input
+			},
+			errExpected: false,
+		},
+		{
+			name: "OK",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+				}
+
+				StorageMock.EXPECT().
+					AddURLs(gomock.Any(), gomock.Any(), model.DedupScopeGlobal).
+					DoAndReturn(func(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
+						s.Require().Len(objs, 1)
+						s.Assert().Equal(input.UserID, objs[0].UserID)
+						s.Assert().Equal(input.URL, objs[0].URL)
+						s.Assert().Len(objs[0].Code, 7)
+
+						objs[0].ID = 1
+						return objs, nil
+					})
 
 				return input
 			},
@@ -89,10 +293,80 @@ func (s *TestSuite) TestService_AddURL() {
 			}
 
 			s.Assert().NoError(err)
+			s.Assert().Equal(1, input.ID)
+			s.Assert().NotEmpty(input.Code)
 		})
 	}
 }
 
+func (s *TestSuite) TestService_AddURL_Policy() {
+	testCases := []struct {
+		url    string
+		reason string
+	}{
+		{url: "javascript:alert(1)", reason: validator.ReasonSchemeNotAllowed},
+		{url: "file:///etc/passwd", reason: validator.ReasonSchemeNotAllowed},
+		{url: "https://lengthy-url.com/" + strings.Repeat("a", 2048), reason: validator.ReasonTooLong},
+		{url: "http://127.0.0.1:8080/a1B2c3D", reason: validator.ReasonSelfLink},
+		{url: "http://localhost/admin", reason: validator.ReasonPrivateHost},
+		{url: "http://10.0.0.1/", reason: validator.ReasonPrivateHost},
+		{url: "http://[::1]/", reason: validator.ReasonPrivateHost},
+		{url: "http://2130706433/", reason: validator.ReasonIPHost},
+		{url: "https://cdn.Blocked.example/", reason: validator.ReasonHostBlocked},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.reason, func() {
+			err := s.svc.AddURL(s.ctx, &model.URL{UserID: uuid.New(), URL: tc.url})
+			s.Require().Error(err)
+			s.Assert().True(errors.Is(err, pkg.ErrInvalidInput))
+			s.Assert().True(strings.HasPrefix(err.Error(), "shortener: AddURL: "), err.Error())
+
+			var inputErr *pkg.InputError
+			s.Require().True(errors.As(err, &inputErr))
+			s.Assert().Equal("url", inputErr.Field)
+			s.Assert().Equal(tc.reason, inputErr.Reason)
+		})
+	}
+}
+
+func (s *TestSuite) TestService_AddURL_LongInput() {
+	// the url fits the length limit only once its tracking params are stripped
+	inputURL := "https://lengthy-url.com/?id=1&utm_source=" + strings.Repeat("a", 2048)
+
+	err := s.svc.AddURL(s.ctx, &model.URL{UserID: uuid.New(), URL: inputURL})
+	s.Require().Error(err)
+	s.Assert().True(errors.Is(err, pkg.ErrInvalidInput))
+
+	var inputErr *pkg.InputError
+	s.Require().True(errors.As(err, &inputErr))
+	s.Assert().Equal("url", inputErr.Field)
+	s.Assert().Equal(validator.ReasonTooLong, inputErr.Reason)
+}
+
+func (s *TestSuite) TestService_AddURL_Canonical() {
+	const (
+		inputURL     = "HTTP://Bücher.Example:80/Path?utm_source=mail&b=2&fbclid=x&a=1"
+		canonicalURL = "http://xn--bcher-kva.example/Path?b=2&a=1"
+	)
+
+	s.stMock.EXPECT().
+		AddURLs(gomock.Any(), gomock.Any(), model.DedupScopeGlobal).
+		DoAndReturn(func(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
+			s.Require().Len(objs, 1)
+			s.Assert().Equal(canonicalURL, objs[0].URL)
+			s.Assert().Equal(inputURL, objs[0].InputURL)
+			objs[0].ID = 1
+			return objs, nil
+		})
+
+	obj := model.URL{UserID: uuid.New(), URL: inputURL}
+	err := s.svc.AddURL(s.ctx, &obj)
+	s.Require().NoError(err)
+	s.Assert().Equal(canonicalURL, obj.URL)
+	s.Assert().Equal(inputURL, obj.DisplayURL())
+}
+
 func (s *TestSuite) TestService_AddBatchURLs() {
 	type testCase struct {
 		name         string
@@ -179,24 +453,19 @@ func (s *TestSuite) TestService_AddBatchURLs() {
 					},
 				}
 
-				urls := []model.URL{
-					{
-						ID:            1,
-						CorrelationID: input[0].CorrelationID,
-						UserID:        input[0].UserID,
-						URL:           input[0].URL,
-					},
-					{
-						ID:            2,
-						CorrelationID: input[1].CorrelationID,
-						UserID:        input[1].UserID,
-						URL:           input[1].URL,
-					},
-				}
-
 				StorageMock.EXPECT().
-					AddURLs(gomock.Any(), input).
-					Return(urls, nil)
+					AddURLs(gomock.Any(), gomock.Any(), model.DedupScopeGlobal).
+					DoAndReturn(func(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
+						s.Require().Len(objs, len(input))
+						s.Assert().NotEqual(objs[0].Code, objs[1].Code)
+						for idx := range objs {
+							s.Assert().Equal(input[idx].CorrelationID, objs[idx].CorrelationID)
+							s.Assert().Equal(input[idx].URL, objs[idx].URL)
+							objs[idx].ID = idx + 1
+						}
+
+						return objs, nil
+					})
 
 				return input
 			},
@@ -210,7 +479,8 @@ func (s *TestSuite) TestService_AddBatchURLs() {
 
 			err := s.svc.AddURLsBatch(s.ctx, &input)
 			if tc.errExpected {
-				s.Assert().Error(err)
+				s.Require().Error(err)
+				s.Assert().True(strings.HasPrefix(err.Error(), "shortener: AddURLsBatch: "), err.Error())
 				if tc.errTarget != nil {
 					s.Assert().True(errors.Is(err, tc.errTarget))
 				}
@@ -227,39 +497,161 @@ func (s *TestSuite) TestService_AddBatchURLs() {
 
 func (s *TestSuite) TestService_GetURL() {
 	type testCase struct {
-		name         string
-		prepareMocks func(StorageMock *storageMock.MockStorage) int
-		errExpected  bool
-		errTarget    error
-		errContains  string
+		name                 string
+		prepareMocks         func(StorageMock *storageMock.MockStorage) string
+		goneExpected         bool
+		redirectCodeExpected int
+		errExpected          bool
+		errTarget            error
+		errContains          string
 	}
 
 	testCases := []testCase{
 		{
-			name: "Fail: invalid input (zero id)",
-			prepareMocks: func(StorageMock *storageMock.MockStorage) int {
-				return 0
+			name: "Fail: invalid input (empty code)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) string {
+				return ""
 			},
 			errExpected: true,
 			errTarget:   pkg.ErrInvalidInput,
-			errContains: "id",
+			errContains: "code",
 		},
 		{
-			name: "Fail: invalid input (negative id)",
-			prepareMocks: func(StorageMock *storageMock.MockStorage) int {
-				return -1
+			name: "Fail: invalid input (invalid code)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) string {
+				return "a/b"
 			},
 			errExpected: true,
 			errTarget:   pkg.ErrInvalidInput,
-			errContains: "id",
+			errContains: "code",
+		},
+		{
+			name: "OK: legacy numeric id",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) string {
+				input := "1"
+
+				url := model.URL{
+					ID:     1,
+					Code:   input,
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+				}
+
+				StorageMock.EXPECT().
+					GetURL(gomock.Any(), input).
+					Return(url, nil)
+
+				return input
+			},
+			redirectCodeExpected: http.StatusTemporaryRedirect,
+			errExpected:          false,
+		},
+		{
+			name: "OK: expired url",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) string {
+				input := "a1B2c3D"
+
+				url := model.URL{
+					ID:        1,
+					Code:      input,
+					UserID:    uuid.New(),
+					URL:       "https://lengthy-url.com/",
+					ExpiresAt: time.Now().Add(-time.Second),
+				}
+
+				StorageMock.EXPECT().
+					GetURL(gomock.Any(), input).
+					Return(url, nil)
+
+				return input
+			},
+			goneExpected: true,
+			errExpected:  false,
+		},
+		{
+			name: "OK: out of clicks url",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) string {
+				input := "a1B2c3D"
+
+				url := model.URL{
+					ID:        1,
+					Code:      input,
+					UserID:    uuid.New(),
+					URL:       "https://lengthy-url.com/",
+					MaxClicks: 1,
+					Clicks:    1,
+				}
+
+				StorageMock.EXPECT().
+					GetURL(gomock.Any(), input).
+					Return(url, nil)
+
+				StorageMock.EXPECT().
+					RedeemURL(gomock.Any(), input).
+					Return(model.URL{}, nil)
+
+				return input
+			},
+			goneExpected: true,
+			errExpected:  false,
+		},
+		{
+			name: "OK: click-limited url",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) string {
+				input := "a1B2c3D"
+
+				url := model.URL{
+					ID:        1,
+					Code:      input,
+					UserID:    uuid.New(),
+					URL:       "https://lengthy-url.com/",
+					MaxClicks: 1,
+				}
+
+				StorageMock.EXPECT().
+					GetURL(gomock.Any(), input).
+					Return(url, nil)
+
+				url.Clicks++
+				StorageMock.EXPECT().
+					RedeemURL(gomock.Any(), input).
+					Return(url, nil)
+
+				return input
+			},
+			redirectCodeExpected: http.StatusTemporaryRedirect,
+			errExpected:          false,
+		},
+		{
+			name: "OK: permanent redirect",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) string {
+				input := "spring-sale"
+
+				url := model.URL{
+					ID:           1,
+					Code:         input,
+					UserID:       uuid.New(),
+					URL:          "https://lengthy-url.com/",
+					RedirectCode: http.StatusPermanentRedirect,
+				}
+
+				StorageMock.EXPECT().
+					GetURL(gomock.Any(), input).
+					Return(url, nil)
+
+				return input
+			},
+			redirectCodeExpected: http.StatusPermanentRedirect,
+			errExpected:          false,
 		},
 		{
 			name: "OK",
-			prepareMocks: func(StorageMock *storageMock.MockStorage) int {
-				input := 1
+			prepareMocks: func(StorageMock *storageMock.MockStorage) string {
+				input := "a1B2c3D"
 
 				url := model.URL{
 					ID:     1,
+					Code:   input,
 					UserID: uuid.New(),
 					URL:    "https://lengthy-url.com/",
 				}
@@ -270,7 +662,8 @@ func (s *TestSuite) TestService_GetURL() {
 
 				return input
 			},
-			errExpected: false,
+			redirectCodeExpected: http.StatusTemporaryRedirect,
+			errExpected:          false,
 		},
 	}
 
@@ -278,7 +671,7 @@ func (s *TestSuite) TestService_GetURL() {
 		s.Run(tc.name, func() {
 			input := tc.prepareMocks(s.stMock)
 
-			_, err := s.svc.GetURL(s.ctx, input)
+			obj, err := s.svc.GetURL(s.ctx, input)
 			if tc.errExpected {
 				s.Assert().Error(err)
 				if tc.errTarget != nil {
@@ -291,17 +684,25 @@ func (s *TestSuite) TestService_GetURL() {
 			}
 
 			s.Assert().NoError(err)
+			if tc.goneExpected {
+				s.Assert().Empty(obj)
+				return
+			}
+			s.Assert().NotEmpty(obj.URL)
+			s.Assert().Equal(tc.redirectCodeExpected, obj.RedirectCode)
 		})
 	}
 }
 
 func (s *TestSuite) TestService_GetUsersURLs() {
 	type testCase struct {
-		name         string
-		prepareMocks func(StorageMock *storageMock.MockStorage) uuid.UUID
-		errExpected  bool
-		errTarget    error
-		errContains  string
+		name               string
+		params             model.ListParams
+		prepareMocks       func(StorageMock *storageMock.MockStorage) uuid.UUID
+		nextCursorExpected bool
+		errExpected        bool
+		errTarget          error
+		errContains        string
 	}
 
 	testCases := []testCase{
@@ -324,20 +725,139 @@ func (s *TestSuite) TestService_GetUsersURLs() {
 				}
 
 				StorageMock.EXPECT().
-					GetUsersURLs(gomock.Any(), input).
+					GetUsersURLs(gomock.Any(), input, model.URLsQuery{Sort: model.ListSortID, Limit: 101}).
+					Return(urls, nil)
+
+				return input
+			},
+			errExpected: false,
+		},
+		{
+			name:   "OK: next page",
+			params: model.ListParams{Cursor: encodeCursor(model.URL{ID: 1}, model.ListSortID, false), Limit: 1},
+			prepareMocks: func(StorageMock *storageMock.MockStorage) uuid.UUID {
+				input := uuid.New()
+
+				urls := []model.URL{
+					{
+						ID:     2,
+						UserID: input,
+						URL:    "https://lengthy-url.com/",
+					},
+					{
+						ID:     3,
+						UserID: input,
+						URL:    "https://another-lengthy-url.com/",
+					},
+				}
+
+				StorageMock.EXPECT().
+					GetUsersURLs(gomock.Any(), input, model.URLsQuery{Sort: model.ListSortID, AfterID: 1, Limit: 2}).
 					Return(urls, nil)
 
 				return input
 			},
+			nextCursorExpected: true,
+			errExpected:        false,
+		},
+		{
+			name:   "Fail: malformed cursor",
+			params: model.ListParams{Cursor: "not-a-cursor"},
+			prepareMocks: func(StorageMock *storageMock.MockStorage) uuid.UUID {
+				return uuid.New()
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "cursor",
+		},
+		{
+			name: "OK: filtered in creation order",
+			params: model.ListParams{
+				Cursor: encodeCursor(model.URL{ID: 1, CreatedAt: time.Unix(0, 100)}, model.ListSortCreatedAt, true),
+				Filter: model.URLsFilter{Search: "lengthy", Domain: "lengthy-url.com"},
+				Sort:   model.ListSortCreatedAt,
+				Desc:   true,
+			},
+			prepareMocks: func(StorageMock *storageMock.MockStorage) uuid.UUID {
+				input := uuid.New()
+
+				query := model.URLsQuery{
+					Filter:         model.URLsFilter{Search: "lengthy", Domain: "lengthy-url.com"},
+					Sort:           model.ListSortCreatedAt,
+					Desc:           true,
+					AfterID:        1,
+					AfterCreatedAt: time.Unix(0, 100),
+					Limit:          101,
+				}
+
+				StorageMock.EXPECT().
+					GetUsersURLs(gomock.Any(), input, query).
+					Return(nil, nil)
+
+				return input
+			},
 			errExpected: false,
 		},
+		{
+			name:   "Fail: cursor of another sort order",
+			params: model.ListParams{Cursor: encodeCursor(model.URL{ID: 1}, model.ListSortID, false), Sort: model.ListSortCreatedAt},
+			prepareMocks: func(StorageMock *storageMock.MockStorage) uuid.UUID {
+				return uuid.New()
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "cursor",
+		},
+		{
+			name:   "Fail: cursor of another direction",
+			params: model.ListParams{Cursor: encodeCursor(model.URL{ID: 1}, model.ListSortID, false), Desc: true},
+			prepareMocks: func(StorageMock *storageMock.MockStorage) uuid.UUID {
+				return uuid.New()
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "cursor",
+		},
+		{
+			name:   "Fail: unsupported sort",
+			params: model.ListParams{Sort: "url"},
+			prepareMocks: func(StorageMock *storageMock.MockStorage) uuid.UUID {
+				return uuid.New()
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "sort",
+		},
+		{
+			name: "Fail: empty creation time range",
+			params: model.ListParams{Filter: model.URLsFilter{
+				CreatedFrom: time.Unix(100, 0),
+				CreatedTo:   time.Unix(100, 0),
+			}},
+			prepareMocks: func(StorageMock *storageMock.MockStorage) uuid.UUID {
+				return uuid.New()
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "created_from",
+		},
+		{
+			name:   "Fail: too big limit",
+			params: model.ListParams{Limit: listMaxLimit + 1},
+			prepareMocks: func(StorageMock *storageMock.MockStorage) uuid.UUID {
+				return uuid.New()
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "limit",
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			input := tc.prepareMocks(s.stMock)
 
-			_, err := s.svc.GetUsersURLs(s.ctx, input)
+			page, err := s.svc.GetUsersURLs(s.ctx, input, tc.params)
 			if tc.errExpected {
 				s.Assert().Error(err)
 				if tc.errTarget != nil {
@@ -350,6 +870,134 @@ func (s *TestSuite) TestService_GetUsersURLs() {
 			}
 
 			s.Assert().NoError(err)
+			if tc.nextCursorExpected {
+				s.Assert().Len(page.URLs, tc.params.Limit)
+				s.Assert().Equal(encodeCursor(page.URLs[len(page.URLs)-1], model.ListSortID, false), page.NextCursor)
+			} else {
+				s.Assert().Empty(page.NextCursor)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestService_UpdateURL() {
+	type testCase struct {
+		name         string
+		prepareMocks func(StorageMock *storageMock.MockStorage) model.URL
+		errExpected  bool
+		errTarget    error
+		errContains  string
+	}
+
+	testCases := []testCase{
+		{
+			name: "Fail: invalid input (nothing to update)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					Code:   "a1B2c3D",
+					UserID: uuid.New(),
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "nothing to update",
+		},
+		{
+			name: "Fail: invalid input (invalid url)",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				return model.URL{
+					Code:   "a1B2c3D",
+					UserID: uuid.New(),
+					URL:    "htp//invalid-url.com/",
+				}
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrInvalidInput,
+			errContains: "url",
+		},
+		{
+			name: "Fail: url of another user",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+				}
+
+				stored := input
+				stored.InputURL = input.URL
+				StorageMock.EXPECT().
+					UpdateURL(gomock.Any(), stored).
+					Return(model.URL{}, nil)
+
+				return input
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrNotFound,
+		},
+		{
+			name: "Fail: url is already shortened",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					Code:   "a1B2c3D",
+					UserID: uuid.New(),
+					URL:    "https://lengthy-url.com/",
+				}
+
+				stored := input
+				stored.InputURL = input.URL
+				StorageMock.EXPECT().
+					UpdateURL(gomock.Any(), stored).
+					Return(model.URL{}, pkg.ErrAlreadyExists)
+
+				return input
+			},
+			errExpected: true,
+			errTarget:   pkg.ErrAlreadyExists,
+		},
+		{
+			name: "OK",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) model.URL {
+				input := model.URL{
+					Code:         "a1B2c3D",
+					UserID:       uuid.New(),
+					URL:          "HTTPS://Lengthy-URL.com:443/?utm_source=mail&id=1",
+					RedirectCode: http.StatusMovedPermanently,
+				}
+
+				stored := input
+				stored.URL = "https://lengthy-url.com/?id=1"
+				stored.InputURL = input.URL
+				updatedObj := stored
+				updatedObj.ID = 1
+				StorageMock.EXPECT().
+					UpdateURL(gomock.Any(), stored).
+					Return(updatedObj, nil)
+
+				return input
+			},
+			errExpected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			input := tc.prepareMocks(s.stMock)
+
+			err := s.svc.UpdateURL(s.ctx, &input)
+			if tc.errExpected {
+				s.Assert().Error(err)
+				if tc.errTarget != nil {
+					s.Assert().True(errors.Is(err, tc.errTarget))
+				}
+				if tc.errContains != "" {
+					s.Assert().Contains(err.Error(), tc.errContains)
+				}
+				return
+			}
+
+			s.Assert().NoError(err)
+			s.Assert().Equal(1, input.ID)
 		})
 	}
 }
@@ -373,26 +1021,60 @@ func (s *TestSuite) TestService_RemoveUserURLs() {
 			errTarget:   pkg.ErrInvalidInput,
 			errContains: "ids",
 		},
+		{
+			name: "Fail: deletions not persisted",
+			prepareMocks: func(StorageMock *storageMock.MockStorage) []model.URL {
+				input := []model.URL{
+					{
+						Code:   "a1B2c3D",
+						UserID: uuid.New(),
+					},
+				}
+
+				StorageMock.EXPECT().
+					AddDeletions(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+
+				return input
+			},
+			errExpected: true,
+			errContains: "connection refused",
+		},
 		{
 			name: "OK: full buffer",
 			prepareMocks: func(StorageMock *storageMock.MockStorage) []model.URL {
 				input := []model.URL{
 					{
-						ID:     1,
+						Code:   "a1B2c3D",
 						UserID: uuid.New(),
 					},
 					{
-						ID:     2,
+						Code:   "e4F5g6H",
 						UserID: uuid.New(),
 					},
 				}
 
 				s.Add(1)
 
-				StorageMock.EXPECT().
-					RemoveUsersURLs(gomock.Any(), input).
-					Do(func(ctx context.Context, objs []model.URL) { s.Done() }).
-					Return(nil)
+				gomock.InOrder(
+					StorageMock.EXPECT().
+						AddDeletions(gomock.Any(), gomock.Any()).
+						DoAndReturn(assignDeletionIDs),
+					StorageMock.EXPECT().
+						RemoveUsersURLs(gomock.Any(), input).
+						Return([]model.DeletionStatus{model.DeletionDeleted, model.DeletionNotOwned}, nil),
+					StorageMock.EXPECT().
+						UpdateDeletions(gomock.Any(), gomock.Any()).
+						Do(func(ctx context.Context, objs []model.Deletion) {
+							defer s.Done()
+
+							s.Require().Len(objs, 2)
+							s.Assert().Equal(model.DeletionDeleted, objs[0].Status)
+							s.Assert().Equal(model.DeletionNotOwned, objs[1].Status)
+							s.Assert().False(objs[1].DoneAt.IsZero())
+						}).
+						Return(nil),
+				)
 
 				return input
 			},
@@ -403,17 +1085,30 @@ func (s *TestSuite) TestService_RemoveUserURLs() {
 			prepareMocks: func(StorageMock *storageMock.MockStorage) []model.URL {
 				input := []model.URL{
 					{
-						ID:     1,
+						Code:   "1",
 						UserID: uuid.New(),
 					},
 				}
 
 				s.Add(1)
 
-				StorageMock.EXPECT().
-					RemoveUsersURLs(gomock.Any(), input).
-					Do(func(ctx context.Context, objs []model.URL) { s.Done() }).
-					Return(nil)
+				gomock.InOrder(
+					StorageMock.EXPECT().
+						AddDeletions(gomock.Any(), gomock.Any()).
+						DoAndReturn(assignDeletionIDs),
+					StorageMock.EXPECT().
+						RemoveUsersURLs(gomock.Any(), input).
+						Return([]model.DeletionStatus{model.DeletionNotFound}, nil),
+					StorageMock.EXPECT().
+						UpdateDeletions(gomock.Any(), gomock.Any()).
+						Do(func(ctx context.Context, objs []model.Deletion) {
+							defer s.Done()
+
+							s.Require().Len(objs, 1)
+							s.Assert().Equal(model.DeletionNotFound, objs[0].Status)
+						}).
+						Return(nil),
+				)
 
 				return input
 			},
@@ -425,7 +1120,7 @@ func (s *TestSuite) TestService_RemoveUserURLs() {
 		s.Run(tc.name, func() {
 			input := tc.prepareMocks(s.stMock)
 
-			err := s.svc.RemoveUsersURLs(s.ctx, input)
+			jobID, err := s.svc.RemoveUsersURLs(s.ctx, input)
 			if tc.errExpected {
 				s.Assert().Error(err)
 				if tc.errTarget != nil {
@@ -440,6 +1135,7 @@ func (s *TestSuite) TestService_RemoveUserURLs() {
 			s.Wait()
 
 			s.Assert().NoError(err)
+			s.Assert().NotEqual(uuid.Nil, jobID)
 		})
 	}
 }
@@ -0,0 +1,191 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/vstdy/go-shortener/model"
+	inter "github.com/vstdy/go-shortener/storage"
+	"github.com/vstdy/go-shortener/storage/file/schema"
+)
+
+// AddClicks adds given click objects to storage
+func (st *Storage) AddClicks(ctx context.Context, objs []model.Click) error {
+	st.clickMu.Lock()
+	defer st.clickMu.Unlock()
+
+	dbObjs := schema.NewClicksFromCanonical(objs)
+	for idx := range dbObjs {
+		dbObjs[idx].ID = st.clickID + idx
+	}
+
+	if err := st.appendClicks(dbObjs); err != nil {
+		return fmt.Errorf("file: AddClicks: %w", err)
+	}
+
+	st.clicks = append(st.clicks, dbObjs...)
+	st.clickID += len(dbObjs)
+
+	return nil
+}
+
+// GetClickStats gets statistics of the url clicks made in [from, to) time range
+func (st *Storage) GetClickStats(
+	ctx context.Context, urlID int, params model.StatsParams, topLimit int) (
+	model.URLStats, error) {
+
+	st.clickMu.RLock()
+	defer st.clickMu.RUnlock()
+
+	var clicks schema.Clicks
+	for _, click := range st.clicks {
+		if click.URLID == urlID && !click.CreatedAt.Before(params.From) && click.CreatedAt.Before(params.To) {
+			clicks = append(clicks, click)
+		}
+	}
+
+	return inter.AggregateClicks(clicks.ToCanonical(), params.Bucket, topLimit), nil
+}
+
+// ListClicks gets at most limit click objects with id greater than afterID ordered by id
+func (st *Storage) ListClicks(ctx context.Context, afterID, limit int) ([]model.Click, error) {
+	st.clickMu.RLock()
+	defer st.clickMu.RUnlock()
+
+	var clicks schema.Clicks
+	for _, click := range st.clicks {
+		if click.ID > afterID {
+			clicks = append(clicks, click)
+		}
+	}
+
+	sort.Slice(clicks, func(i, j int) bool {
+		return clicks[i].ID < clicks[j].ID
+	})
+	if len(clicks) > limit {
+		clicks = clicks[:limit]
+	}
+
+	return clicks.ToCanonical(), nil
+}
+
+// ImportClicks adds given click objects to storage keeping their ids
+// Objects with already stored ids are skipped, so an interrupted import can be repeated.
+func (st *Storage) ImportClicks(ctx context.Context, objs []model.Click) error {
+	st.clickMu.Lock()
+	defer st.clickMu.Unlock()
+
+	ids := make(map[int]bool, len(st.clicks))
+	for _, click := range st.clicks {
+		ids[click.ID] = true
+	}
+
+	var newObjs schema.Clicks
+	for _, click := range schema.NewClicksFromCanonical(objs) {
+		if ids[click.ID] {
+			continue
+		}
+
+		newObjs = append(newObjs, click)
+		ids[click.ID] = true
+	}
+
+	if err := st.appendClicks(newObjs); err != nil {
+		return fmt.Errorf("file: ImportClicks: %w", err)
+	}
+
+	for _, click := range newObjs {
+		st.clicks = append(st.clicks, click)
+		if click.ID >= st.clickID {
+			st.clickID = click.ID + 1
+		}
+	}
+
+	return nil
+}
+
+// CompactClicks rewrites the clicks log dropping clicks out of configured retention.
+func (st *Storage) CompactClicks() error {
+	st.clickMu.Lock()
+	defer st.clickMu.Unlock()
+
+	if err := st.compactClicks(time.Now()); err != nil {
+		return fmt.Errorf("file: CompactClicks: %w", err)
+	}
+
+	return nil
+}
+
+// appendClicks appends click object records to the clicks log with a single write.
+// The log is compacted beforehand once it reaches configured size
+// and has doubled since the last compaction.
+func (st *Storage) appendClicks(clicks schema.Clicks) error {
+	if len(clicks) == 0 {
+		return nil
+	}
+
+	if st.config.CompactSize > 0 && st.clickSize >= st.config.CompactSize && st.clickSize >= 2*st.clickCompactedSize {
+		if err := st.compactClicks(time.Now()); err != nil {
+			return fmt.Errorf("compacting: %w", err)
+		}
+	}
+
+	lines, err := marshalRecords(len(clicks), func(idx int) interface{} {
+		return clicks[idx]
+	})
+	if err != nil {
+		return err
+	}
+
+	size, err := writeLog(st.clickFile, st.clickSize, lines, st.config.FsyncPolicy == FsyncAlways)
+	if err != nil {
+		return err
+	}
+	st.clickSize = size
+
+	return nil
+}
+
+// compactClicks replaces the clicks log with a new one holding clicks within configured retention.
+// Clicks out of retention are dropped from memory as well.
+func (st *Storage) compactClicks(now time.Time) error {
+	clicks := st.retainedClicks(st.clicks, now)
+
+	file, size, err := writeRecordsFile(st.config.ClickStoragePath, len(clicks), func(idx int) interface{} {
+		return clicks[idx]
+	})
+	if err != nil {
+		return err
+	}
+
+	closeErr := st.clickFile.Close()
+	st.clickFile = file
+	st.clickSize = size
+	st.clickCompactedSize = size
+	st.clicks = clicks
+
+	if closeErr != nil {
+		return fmt.Errorf("closing replaced log: %w", closeErr)
+	}
+
+	return nil
+}
+
+// retainedClicks returns clicks made within configured retention by given time.
+func (st *Storage) retainedClicks(clicks schema.Clicks, now time.Time) schema.Clicks {
+	if st.config.ClickRetention == 0 {
+		return clicks
+	}
+
+	since := now.Add(-st.config.ClickRetention)
+	retained := make(schema.Clicks, 0, len(clicks))
+	for _, click := range clicks {
+		if !click.CreatedAt.Before(since) {
+			retained = append(retained, click)
+		}
+	}
+
+	return retained
+}
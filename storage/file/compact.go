@@ -0,0 +1,203 @@
+package file
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/vstdy/go-shortener/storage/file/schema"
+)
+
+const snapshotExt = ".snapshot"
+
+// Compact rewrites the log to the latest records of url objects.
+// The log is replaced atomically, snapshot records are moved to the log.
+func (st *Storage) Compact() error {
+	st.Lock()
+	defer st.Unlock()
+
+	if err := st.compact(); err != nil {
+		return fmt.Errorf("file: Compact: %w", err)
+	}
+
+	return nil
+}
+
+// Snapshot writes the latest records of url objects to the snapshot file and truncates the log.
+// Nothing is written when the log is empty.
+func (st *Storage) Snapshot() error {
+	st.Lock()
+	defer st.Unlock()
+
+	if st.size == 0 {
+		return nil
+	}
+
+	file, _, err := writeURLsFile(st.snapshotPath(), st.sortedURLs())
+	if err != nil {
+		return fmt.Errorf("file: Snapshot: %w", err)
+	}
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("file: Snapshot: %w", err)
+	}
+
+	// replaying the log over the snapshot is idempotent, so a crash before truncation loses nothing
+	if err = st.file.Truncate(0); err != nil {
+		return fmt.Errorf("file: Snapshot: truncating log: %w", err)
+	}
+	if _, err = st.file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("file: Snapshot: truncating log: %w", err)
+	}
+	if st.config.FsyncPolicy == FsyncAlways {
+		if err = st.file.Sync(); err != nil {
+			return fmt.Errorf("file: Snapshot: truncating log: %w", err)
+		}
+	}
+
+	st.size = 0
+	st.compactedSize = 0
+
+	return nil
+}
+
+// appendURL appends url object record to the log.
+func (st *Storage) appendURL(url schema.URL) error {
+	return st.appendURLs(schema.URLS{url})
+}
+
+// appendURLs appends url objects records to the log with a single write.
+// The log is compacted beforehand once it reaches configured size
+// and has doubled since the last compaction.
+func (st *Storage) appendURLs(urls schema.URLS) error {
+	if len(urls) == 0 {
+		return nil
+	}
+
+	if st.config.CompactSize > 0 && st.size >= st.config.CompactSize && st.size >= 2*st.compactedSize {
+		if err := st.compact(); err != nil {
+			return fmt.Errorf("compacting: %w", err)
+		}
+	}
+
+	lines, err := marshalRecords(len(urls), func(idx int) interface{} {
+		return urls[idx]
+	})
+	if err != nil {
+		return err
+	}
+
+	size, err := writeLog(st.file, st.size, lines, st.config.FsyncPolicy == FsyncAlways)
+	if err != nil {
+		return err
+	}
+	st.size = size
+
+	return nil
+}
+
+// compact replaces the log with a new one holding the latest records of url objects.
+func (st *Storage) compact() error {
+	file, size, err := writeURLsFile(st.config.FileStoragePath, st.sortedURLs())
+	if err != nil {
+		return err
+	}
+
+	// the new log is in place already, so the storage switches to it even if closing the old one fails
+	closeErr := st.file.Close()
+	st.file = file
+	st.size = size
+	st.compactedSize = size
+
+	if err = os.Remove(st.snapshotPath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing snapshot: %w", err)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("closing replaced log: %w", closeErr)
+	}
+
+	return nil
+}
+
+// sortedURLs returns url objects ordered by id.
+func (st *Storage) sortedURLs() schema.URLS {
+	urls := make(schema.URLS, 0, len(st.urls))
+	for _, url := range st.urls {
+		urls = append(urls, url)
+	}
+
+	sort.Slice(urls, func(i, j int) bool {
+		return urls[i].ID < urls[j].ID
+	})
+
+	return urls
+}
+
+// snapshotPath returns full path to snapshot file.
+func (st *Storage) snapshotPath() string {
+	return st.config.FileStoragePath + snapshotExt
+}
+
+// writeURLsFile writes url objects records to a temp file and renames it to given path.
+// Returns the renamed file positioned at its end and its size.
+func writeURLsFile(path string, urls schema.URLS) (*os.File, int64, error) {
+	return writeRecordsFile(path, len(urls), func(idx int) interface{} {
+		return urls[idx]
+	})
+}
+
+// writeRecordsFile writes given number of records to a temp file and renames it to given path.
+// Returns the renamed file positioned at its end and its size.
+func writeRecordsFile(path string, cnt int, record func(idx int) interface{}) (*os.File, int64, error) {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fail := func(err error) (*os.File, int64, error) {
+		file.Close()
+		os.Remove(file.Name())
+
+		return nil, 0, err
+	}
+
+	writer := bufio.NewWriter(file)
+	for idx := 0; idx < cnt; idx++ {
+		line, err := marshalRecord(record(idx))
+		if err != nil {
+			return fail(err)
+		}
+		if _, err = writer.Write(line); err != nil {
+			return fail(err)
+		}
+	}
+
+	if err = writer.Flush(); err != nil {
+		return fail(err)
+	}
+	if err = file.Chmod(0644); err != nil {
+		return fail(err)
+	}
+	if err = file.Sync(); err != nil {
+		return fail(err)
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fail(err)
+	}
+
+	if err = os.Rename(file.Name(), path); err != nil {
+		return fail(err)
+	}
+
+	if err = syncDir(filepath.Dir(path)); err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+
+	return file, size, nil
+}
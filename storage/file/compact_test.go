@@ -0,0 +1,188 @@
+package file
+
+import (
+	"bufio"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+func (s *TestSuite) TestStorage_Compact() {
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:      "a1B2c3D",
+			UserID:    uuid.New(),
+			URL:       "https://lengthy-url-1.com/",
+			MaxClicks: 10,
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		_, err = s.storage.RedeemURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+	}
+	s.Require().Equal(4, s.countLines(s.config.FileStoragePath))
+
+	s.Run("Compact log to latest records", func() {
+		s.Require().NoError(s.storage.Compact())
+		s.Assert().Equal(1, s.countLines(s.config.FileStoragePath))
+
+		s.reopen()
+
+		res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(3, res.Clicks)
+	})
+
+	s.Run("Append after compaction", func() {
+		_, err := s.storage.RedeemURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(2, s.countLines(s.config.FileStoragePath))
+
+		s.reopen()
+
+		res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(4, res.Clicks)
+	})
+}
+
+func (s *TestSuite) TestStorage_CompactSize() {
+	s.config.CompactSize = 1
+	s.reopen()
+
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-1.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	for i := 0; i < 5; i++ {
+		_, err = s.storage.RedeemURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+	}
+
+	// log is compacted once it doubles since the last compaction
+	s.Assert().LessOrEqual(s.countLines(s.config.FileStoragePath), 3)
+
+	s.reopen()
+
+	res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+	s.Require().NoError(err)
+	s.Assert().Equal(5, res.Clicks)
+}
+
+func (s *TestSuite) TestStorage_Snapshot() {
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-1.com/",
+		},
+		{
+			Code:   "e4F5g6H",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-2.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	s.Run("Snapshot truncates log", func() {
+		s.Require().NoError(s.storage.Snapshot())
+		s.Assert().Equal(2, s.countLines(s.storage.snapshotPath()))
+		s.Assert().Equal(0, s.countLines(s.config.FileStoragePath))
+	})
+
+	s.Run("Load snapshot with tail log", func() {
+		_, err := s.storage.RemoveUsersURLs(s.ctx, urls[:1])
+		s.Require().NoError(err)
+		s.Assert().Equal(1, s.countLines(s.config.FileStoragePath))
+
+		s.reopen()
+
+		res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+
+		res, err = s.storage.GetURL(s.ctx, urls[1].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(urls[1].URL, res.URL)
+
+		added, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "i7J8k9L",
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-3.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+		s.Assert().Equal(3, added[0].ID)
+	})
+
+	s.Run("Compaction removes snapshot", func() {
+		s.Require().NoError(s.storage.Compact())
+		s.Assert().Equal(3, s.countLines(s.config.FileStoragePath))
+
+		_, err := os.Stat(s.storage.snapshotPath())
+		s.Assert().True(os.IsNotExist(err))
+	})
+}
+
+// countLines counts records in the file.
+func (s *TestSuite) countLines(path string) int {
+	file, err := os.Open(path)
+	s.Require().NoError(err)
+	defer file.Close()
+
+	cnt := 0
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		cnt++
+	}
+
+	return cnt
+}
+
+func (s *TestSuite) TestStorage_CompactClicks() {
+	s.config.ClickRetention = time.Hour
+	s.reopen()
+
+	now := time.Now()
+	err := s.storage.AddClicks(s.ctx, []model.Click{
+		{URLID: 1, CreatedAt: now.Add(-2 * time.Hour)},
+		{URLID: 1, CreatedAt: now.Add(-time.Minute)},
+		{URLID: 1, CreatedAt: now},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(3, s.countLines(s.config.ClickStoragePath))
+
+	s.Run("Clicks out of retention are not loaded", func() {
+		s.reopen()
+
+		params := model.StatsParams{From: now.Add(-24 * time.Hour), To: now.Add(time.Hour), Bucket: time.Hour}
+		stats, err := s.storage.GetClickStats(s.ctx, 1, params, 10)
+		s.Require().NoError(err)
+		s.Assert().Equal(2, stats.TotalClicks)
+	})
+
+	s.Run("Compaction drops clicks out of retention", func() {
+		s.Require().NoError(s.storage.CompactClicks())
+		s.Assert().Equal(2, s.countLines(s.config.ClickStoragePath))
+
+		err := s.storage.AddClicks(s.ctx, []model.Click{{URLID: 1, CreatedAt: now}})
+		s.Require().NoError(err)
+
+		s.reopen()
+
+		clicks, err := s.storage.ListClicks(s.ctx, 0, 10)
+		s.Require().NoError(err)
+		s.Require().Len(clicks, 3)
+		s.Assert().Equal(4, clicks[2].ID, "ids are not reused")
+	})
+}
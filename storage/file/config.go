@@ -4,15 +4,32 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"time"
 )
 
 const (
-	defaultFileStorageName = "storage_file.txt"
+	defaultFileStorageName  = "storage_file.txt"
+	defaultClickStorageName = "storage_clicks.txt"
+	defaultDelStorageName   = "storage_deletions.txt"
+
+	// FsyncAlways syncs every appended record to disk.
+	FsyncAlways = "always"
+	// FsyncInterval syncs appended records to disk every configured interval.
+	FsyncInterval = "interval"
+	// FsyncNever leaves syncing to the OS.
+	FsyncNever = "never"
 )
 
 // Config keeps Storage configuration.
 type Config struct {
-	FileStoragePath string `mapstructure:"file_storage_path"`
+	FileStoragePath  string        `mapstructure:"file_storage_path"`
+	ClickStoragePath string        `mapstructure:"click_storage_path"`
+	DelStoragePath   string        `mapstructure:"deletion_storage_path"`
+	CompactSize      int64         `mapstructure:"file_compact_size"`
+	SnapshotInterval time.Duration `mapstructure:"file_snapshot_interval"`
+	FsyncPolicy      string        `mapstructure:"file_fsync_policy"`
+	FsyncInterval    time.Duration `mapstructure:"file_fsync_interval"`
+	ClickRetention   time.Duration `mapstructure:"file_click_retention"`
 }
 
 // Validate performs a basic validation.
@@ -21,22 +38,58 @@ func (config Config) Validate() error {
 		return fmt.Errorf("%s field: empty", "FileStoragePath")
 	}
 
+	if config.ClickStoragePath == "" {
+		return fmt.Errorf("%s field: empty", "ClickStoragePath")
+	}
+
+	if config.DelStoragePath == "" {
+		return fmt.Errorf("%s field: empty", "DelStoragePath")
+	}
+
+	if config.CompactSize < 0 {
+		return fmt.Errorf("%s field: too small value", "file_compact_size")
+	}
+
+	if config.SnapshotInterval != 0 && config.SnapshotInterval < time.Second {
+		return fmt.Errorf("%s field: too short period", "file_snapshot_interval")
+	}
+
+	if config.ClickRetention != 0 && config.ClickRetention < time.Hour {
+		return fmt.Errorf("%s field: too short period", "file_click_retention")
+	}
+
+	switch config.FsyncPolicy {
+	case FsyncAlways, FsyncNever:
+	case FsyncInterval:
+		if config.FsyncInterval <= 0 {
+			return fmt.Errorf("%s field: too short period", "file_fsync_interval")
+		}
+	default:
+		return fmt.Errorf("%s field: unsupported value %q", "file_fsync_policy", config.FsyncPolicy)
+	}
+
 	return nil
 }
 
 // NewDefaultConfig builds a Config with default values.
 func NewDefaultConfig() Config {
 	return Config{
-		FileStoragePath: defaultFileStoragePath(),
+		FileStoragePath:  defaultFileStoragePath(defaultFileStorageName),
+		ClickStoragePath: defaultFileStoragePath(defaultClickStorageName),
+		DelStoragePath:   defaultFileStoragePath(defaultDelStorageName),
+		CompactSize:      64 << 20,
+		FsyncPolicy:      FsyncInterval,
+		FsyncInterval:    time.Second,
+		ClickRetention:   90 * 24 * time.Hour,
 	}
 }
 
-// defaultFileStoragePath returns full path to storage file.
-func defaultFileStoragePath() string {
+// defaultFileStoragePath returns full path to storage file with given name.
+func defaultFileStoragePath(name string) string {
 	_, filePath, _, ok := runtime.Caller(1)
 	if !ok {
 		return ""
 	}
 
-	return filepath.Dir(filePath) + "/" + defaultFileStorageName
+	return filepath.Dir(filePath) + "/" + name
 }
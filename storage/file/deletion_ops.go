@@ -0,0 +1,266 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/storage/file/schema"
+)
+
+// delCompactRecords is the deletions journal records number the journal is rewritten at
+// once it holds twice as many records as stored deletions.
+const delCompactRecords = 1024
+
+// AddDeletions adds given pending deletion objects to storage
+// Objects are appended to the deletions journal.
+func (st *Storage) AddDeletions(ctx context.Context, objs []model.Deletion) ([]model.Deletion, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	dbObjs := schema.NewDeletionsFromCanonical(objs)
+	for idx := range dbObjs {
+		dbObjs[idx].ID = st.deletionID
+		if dbObjs[idx].CreatedAt.IsZero() {
+			dbObjs[idx].CreatedAt = time.Now()
+		}
+		if dbObjs[idx].Status == "" {
+			dbObjs[idx].Status = model.DeletionPending
+		}
+
+		if err := st.appendDeletion(dbObjs[idx]); err != nil {
+			return nil, fmt.Errorf("file: AddDeletions: %w", err)
+		}
+
+		st.deletions[dbObjs[idx].ID] = dbObjs[idx]
+		st.deletionID++
+	}
+
+	return dbObjs.ToCanonical(), nil
+}
+
+// ClaimDueDeletions gets at most limit pending deletion objects to be attempted by given time ordered by id
+// Next attempt of the objects is postponed by given lease, so they are not claimed again until it expires.
+// Leases are kept in memory only, claimed objects are due again after restart.
+func (st *Storage) ClaimDueDeletions(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]model.Deletion, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	deletions := st.listDeletions(limit, func(deletion schema.Deletion) bool {
+		return deletion.Status == model.DeletionPending && deletion.DeadAt.IsZero() &&
+			!deletion.NextAttemptAt.After(now)
+	})
+
+	for idx := range deletions {
+		deletions[idx].NextAttemptAt = now.Add(lease)
+
+		deletion := st.deletions[deletions[idx].ID]
+		deletion.NextAttemptAt = deletions[idx].NextAttemptAt
+		st.deletions[deletion.ID] = deletion
+	}
+
+	return deletions, nil
+}
+
+// HasPendingDeletions checks whether any deletion object is pending regardless of its next attempt time
+func (st *Storage) HasPendingDeletions(ctx context.Context) (bool, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	for _, deletion := range st.deletions {
+		if deletion.Status == model.DeletionPending && deletion.DeadAt.IsZero() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetJobDeletions gets deletion objects of the job with given id ordered by id
+func (st *Storage) GetJobDeletions(ctx context.Context, jobID uuid.UUID) ([]model.Deletion, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	return st.listDeletions(len(st.deletions), func(deletion schema.Deletion) bool {
+		return deletion.JobID == jobID
+	}), nil
+}
+
+// UpdateDeletions updates status, attempts, last error, next attempt, done and dead time of given deletion objects
+// Updated objects are appended to the deletions journal, objects missing in storage are skipped.
+func (st *Storage) UpdateDeletions(ctx context.Context, objs []model.Deletion) error {
+	st.Lock()
+	defer st.Unlock()
+
+	for _, obj := range schema.NewDeletionsFromCanonical(objs) {
+		deletion, ok := st.deletions[obj.ID]
+		if !ok {
+			continue
+		}
+
+		deletion.Status = obj.Status
+		deletion.Attempts = obj.Attempts
+		deletion.LastError = obj.LastError
+		deletion.NextAttemptAt = obj.NextAttemptAt
+		deletion.DoneAt = obj.DoneAt
+		deletion.DeadAt = obj.DeadAt
+
+		if err := st.appendDeletion(deletion); err != nil {
+			return fmt.Errorf("file: UpdateDeletions: %w", err)
+		}
+
+		st.deletions[obj.ID] = deletion
+	}
+
+	return nil
+}
+
+// RemoveDoneDeletions removes deletion objects done before given time
+// Removed objects are appended to the deletions journal as done records,
+// the journal is rewritten to stored objects once it grows enough.
+func (st *Storage) RemoveDoneDeletions(ctx context.Context, before time.Time) (int, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	cnt := 0
+	for id, deletion := range st.deletions {
+		if deletion.DoneAt.IsZero() || !deletion.DoneAt.Before(before) {
+			continue
+		}
+
+		if err := st.appendDeletion(schema.Deletion{ID: id, Done: true}); err != nil {
+			return cnt, fmt.Errorf("file: RemoveDoneDeletions: %w", err)
+		}
+
+		delete(st.deletions, id)
+		cnt++
+	}
+
+	if st.delRecords >= delCompactRecords && st.delRecords >= 2*len(st.deletions) {
+		if err := st.compactDeletions(); err != nil {
+			return cnt, fmt.Errorf("file: RemoveDoneDeletions: compacting: %w", err)
+		}
+	}
+
+	return cnt, nil
+}
+
+// GetDeadDeletions gets at most limit dead deletion objects with id greater than afterID ordered by id
+func (st *Storage) GetDeadDeletions(ctx context.Context, afterID, limit int) ([]model.Deletion, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	return st.listDeletions(limit, func(deletion schema.Deletion) bool {
+		return deletion.ID > afterID && !deletion.DeadAt.IsZero()
+	}), nil
+}
+
+// loadDeletions opens the deletions journal and replays its records.
+func (st *Storage) loadDeletions() error {
+	st.deletions = make(map[int]schema.Deletion)
+
+	var maxID int
+	file, _, err := openLog(st.config.DelStoragePath, func(line []byte) error {
+		var deletion schema.Deletion
+		if err := unmarshalRecord(line, &deletion); err != nil {
+			return err
+		}
+
+		// records written before deletion statuses are pending
+		if deletion.Status == "" {
+			deletion.Status = model.DeletionPending
+		}
+
+		if deletion.Done {
+			delete(st.deletions, deletion.ID)
+		} else {
+			st.deletions[deletion.ID] = deletion
+		}
+		if deletion.ID > maxID {
+			maxID = deletion.ID
+		}
+		st.delRecords++
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	st.delFile = file
+	st.deletionID = maxID + 1
+
+	return nil
+}
+
+// listDeletions returns at most limit deletion objects matching given filter ordered by id.
+func (st *Storage) listDeletions(limit int, match func(deletion schema.Deletion) bool) []model.Deletion {
+	var deletions schema.Deletions
+	for _, deletion := range st.deletions {
+		if match(deletion) {
+			deletions = append(deletions, deletion)
+		}
+	}
+
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i].ID < deletions[j].ID
+	})
+	if len(deletions) > limit {
+		deletions = deletions[:limit]
+	}
+
+	return deletions.ToCanonical()
+}
+
+// appendDeletion appends deletion object record to the deletions journal.
+func (st *Storage) appendDeletion(deletion schema.Deletion) error {
+	line, err := marshalRecord(deletion)
+	if err != nil {
+		return err
+	}
+
+	if _, err = st.delFile.Write(line); err != nil {
+		return err
+	}
+	st.delRecords++
+
+	if st.config.FsyncPolicy == FsyncAlways {
+		return st.delFile.Sync()
+	}
+
+	return nil
+}
+
+// compactDeletions replaces the deletions journal with a new one holding stored deletion objects.
+// A done record of the last id is kept, so ids aren't reused after reload.
+func (st *Storage) compactDeletions() error {
+	records := make(schema.Deletions, 0, len(st.deletions)+1)
+	for _, deletion := range st.deletions {
+		records = append(records, deletion)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].ID < records[j].ID
+	})
+
+	lastID := st.deletionID - 1
+	if lastID > 0 && (len(records) == 0 || records[len(records)-1].ID != lastID) {
+		records = append(records, schema.Deletion{ID: lastID, Done: true})
+	}
+
+	file, _, err := writeRecordsFile(st.config.DelStoragePath, len(records), func(idx int) interface{} {
+		return records[idx]
+	})
+	if err != nil {
+		return err
+	}
+
+	st.delFile.Close()
+	st.delFile = file
+	st.delRecords = len(records)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package file
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+func (s *TestSuite) TestDeletions() {
+	now := time.Now()
+	userID, jobID := uuid.New(), uuid.New()
+
+	dels, err := s.storage.AddDeletions(s.ctx, []model.Deletion{
+		{JobID: jobID, UserID: userID, Code: "a1B2c3D", NextAttemptAt: now.Add(-time.Minute)},
+		{JobID: jobID, UserID: userID, Code: "e4F5g6H", NextAttemptAt: now.Add(time.Minute)},
+		{JobID: jobID, UserID: userID, Code: "i7J8k9L", NextAttemptAt: now.Add(-time.Second)},
+		{JobID: uuid.New(), UserID: userID, Code: "q1R2s3T", NextAttemptAt: now.Add(time.Minute)},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(dels, 4)
+
+	dead := dels[0]
+	dead.Attempts = 3
+	dead.LastError = "connection refused"
+	dead.DeadAt = now
+	deleted := dels[1]
+	deleted.Status, deleted.DoneAt = model.DeletionDeleted, now
+	expired := dels[3]
+	expired.Status, expired.DoneAt = model.DeletionNotFound, now.Add(-time.Hour)
+	s.Require().NoError(s.storage.UpdateDeletions(s.ctx, []model.Deletion{dead, deleted, expired}))
+
+	removed, err := s.storage.RemoveDoneDeletions(s.ctx, now.Add(-time.Minute))
+	s.Require().NoError(err)
+	s.Require().Equal(1, removed)
+
+	s.Run("Replay deletions journal", func() {
+		s.reopen()
+
+		res, err := s.storage.ClaimDueDeletions(s.ctx, now, 10, 0)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(dels[2].ID, res[0].ID)
+		s.Assert().Equal(dels[2].Code, res[0].Code)
+
+		pending, err := s.storage.HasPendingDeletions(s.ctx)
+		s.Require().NoError(err)
+		s.Assert().True(pending)
+
+		res, err = s.storage.GetDeadDeletions(s.ctx, 0, 10)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(dead.ID, res[0].ID)
+		s.Assert().Equal(3, res[0].Attempts)
+		s.Assert().Equal("connection refused", res[0].LastError)
+
+		res, err = s.storage.GetJobDeletions(s.ctx, jobID)
+		s.Require().NoError(err)
+		s.Require().Len(res, 3)
+		s.Assert().Equal(model.DeletionDeleted, res[1].Status)
+		s.Assert().True(res[1].DoneAt.Equal(now))
+
+		res, err = s.storage.GetJobDeletions(s.ctx, expired.JobID)
+		s.Require().NoError(err)
+		s.Assert().Empty(res)
+
+		added, err := s.storage.AddDeletions(s.ctx, []model.Deletion{{UserID: userID, Code: "m1N2o3P"}})
+		s.Require().NoError(err)
+		s.Assert().Equal(5, added[0].ID)
+	})
+}
+
+func (s *TestSuite) TestDeletions_Claim() {
+	now := time.Now()
+	userID := uuid.New()
+
+	dels, err := s.storage.AddDeletions(s.ctx, []model.Deletion{
+		{JobID: uuid.New(), UserID: userID, Code: "a1B2c3D", NextAttemptAt: now.Add(-time.Minute)},
+		{JobID: uuid.New(), UserID: userID, Code: "e4F5g6H", NextAttemptAt: now.Add(-time.Second)},
+	})
+	s.Require().NoError(err)
+
+	res, err := s.storage.ClaimDueDeletions(s.ctx, now, 1, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Assert().Equal(dels[0].ID, res[0].ID)
+	s.Assert().True(res[0].NextAttemptAt.Equal(now.Add(time.Minute)))
+
+	res, err = s.storage.ClaimDueDeletions(s.ctx, now, 10, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Assert().Equal(dels[1].ID, res[0].ID)
+
+	res, err = s.storage.ClaimDueDeletions(s.ctx, now, 10, time.Minute)
+	s.Require().NoError(err)
+	s.Assert().Empty(res)
+
+	// deletions are claimed again once their lease expires
+	res, err = s.storage.ClaimDueDeletions(s.ctx, now.Add(time.Minute), 10, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(res, 2)
+
+	for idx := range res {
+		res[idx].Status, res[idx].DoneAt = model.DeletionDeleted, now
+	}
+	s.Require().NoError(s.storage.UpdateDeletions(s.ctx, res))
+}
+
+func (s *TestSuite) TestDeletions_Compact() {
+	objs := make([]model.Deletion, delCompactRecords)
+	for idx := range objs {
+		objs[idx] = model.Deletion{UserID: uuid.New(), Code: "a1B2c3D"}
+	}
+
+	dels, err := s.storage.AddDeletions(s.ctx, objs)
+	s.Require().NoError(err)
+
+	doneAt := time.Now().Add(-time.Hour)
+	done := make([]model.Deletion, 0, len(dels))
+	for _, deletion := range dels[1:] {
+		deletion.Status, deletion.DoneAt = model.DeletionDeleted, doneAt
+		done = append(done, deletion)
+	}
+	s.Require().NoError(s.storage.UpdateDeletions(s.ctx, done))
+
+	removed, err := s.storage.RemoveDoneDeletions(s.ctx, time.Now())
+	s.Require().NoError(err)
+	s.Require().Equal(len(done), removed)
+	// the stored deletion and the done record of the last id are left
+	s.Assert().Equal(2, s.countLines(s.config.DelStoragePath))
+
+	s.reopen()
+
+	res, err := s.storage.ClaimDueDeletions(s.ctx, time.Now(), 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Assert().Equal(dels[0].ID, res[0].ID)
+
+	added, err := s.storage.AddDeletions(s.ctx, []model.Deletion{{UserID: uuid.New(), Code: "e4F5g6H"}})
+	s.Require().NoError(err)
+	s.Assert().Equal(delCompactRecords+1, added[0].ID)
+}
@@ -0,0 +1,19 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package file
+
+import (
+	"os"
+)
+
+// lockFile opens the file at given path.
+// Advisory locks are not supported on this platform, so the file is not locked.
+func lockFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+}
+
+// syncDir is a no-op, directories can't be synced on this platform.
+func syncDir(path string) error {
+	return nil
+}
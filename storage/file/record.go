@@ -0,0 +1,99 @@
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// record keeps a storage record with its checksum.
+type record struct {
+	CRC  uint32          `json:"crc"`
+	Data json.RawMessage `json:"data"`
+}
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errChecksumMismatch = errors.New("checksum mismatch")
+)
+
+// marshalRecord encodes given object to a checksummed record line.
+func marshalRecord(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	line, err := json.Marshal(record{CRC: crc32.Checksum(data, crcTable), Data: data})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(line, '\n'), nil
+}
+
+// marshalRecords encodes given number of objects to checksummed record lines.
+func marshalRecords(cnt int, record func(idx int) interface{}) ([]byte, error) {
+	var lines []byte
+	for idx := 0; idx < cnt; idx++ {
+		line, err := marshalRecord(record(idx))
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line...)
+	}
+
+	return lines, nil
+}
+
+// unmarshalRecord decodes checksummed record line to given object.
+// Records written before checksums are decoded as is.
+func unmarshalRecord(line []byte, v interface{}) error {
+	var rec record
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return err
+	}
+
+	if rec.Data == nil {
+		return json.Unmarshal(line, v)
+	}
+
+	if crc32.Checksum(rec.Data, crcTable) != rec.CRC {
+		return errChecksumMismatch
+	}
+
+	return json.Unmarshal(rec.Data, v)
+}
+
+// readRecords reads record lines passing each one to given decode function.
+// A partial or corrupted last record is a torn write and is skipped,
+// corrupted records followed by others fail the read.
+// Returns size of the valid records prefix.
+func readRecords(r io.Reader, decode func(line []byte) error) (int64, error) {
+	reader := bufio.NewReader(r)
+
+	var size int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return size, err
+		}
+		if len(line) == 0 || line[len(line)-1] != '\n' {
+			return size, nil
+		}
+
+		if err = decode(line); err != nil {
+			if _, peekErr := reader.Peek(1); peekErr == io.EOF {
+				return size, nil
+			}
+
+			return size, fmt.Errorf("record at offset %d: %w", size, err)
+		}
+
+		size += int64(len(line))
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+type (
+	// Deletion is a deletion journal record, Done records tombstone removed deletions.
+	Deletion struct {
+		ID            int                  `json:"id"`
+		JobID         uuid.UUID            `json:"job_id"`
+		UserID        uuid.UUID            `json:"user_id"`
+		Code          string               `json:"code"`
+		Status        model.DeletionStatus `json:"status"`
+		Attempts      int                  `json:"attempts"`
+		LastError     string               `json:"last_error"`
+		NextAttemptAt time.Time            `json:"next_attempt_at"`
+		CreatedAt     time.Time            `json:"created_at"`
+		DoneAt        time.Time            `json:"done_at"`
+		DeadAt        time.Time            `json:"dead_at"`
+		Done          bool                 `json:"done,omitempty"`
+	}
+
+	Deletions []Deletion
+)
+
+// NewDeletionsFromCanonical creates new list of Deletion storage objects from canonical model.
+func NewDeletionsFromCanonical(objs []model.Deletion) Deletions {
+	var deletions Deletions
+	for _, deletion := range objs {
+		deletions = append(deletions, Deletion{
+			ID:            deletion.ID,
+			JobID:         deletion.JobID,
+			UserID:        deletion.UserID,
+			Code:          deletion.Code,
+			Status:        deletion.Status,
+			Attempts:      deletion.Attempts,
+			LastError:     deletion.LastError,
+			NextAttemptAt: deletion.NextAttemptAt,
+			CreatedAt:     deletion.CreatedAt,
+			DoneAt:        deletion.DoneAt,
+			DeadAt:        deletion.DeadAt,
+		})
+	}
+
+	return deletions
+}
+
+// ToCanonical converts a storage object to canonical model.
+func (d Deletion) ToCanonical() model.Deletion {
+	return model.Deletion{
+		ID:            d.ID,
+		JobID:         d.JobID,
+		UserID:        d.UserID,
+		Code:          d.Code,
+		Status:        d.Status,
+		Attempts:      d.Attempts,
+		LastError:     d.LastError,
+		NextAttemptAt: d.NextAttemptAt,
+		CreatedAt:     d.CreatedAt,
+		DoneAt:        d.DoneAt,
+		DeadAt:        d.DeadAt,
+	}
+}
+
+// ToCanonical converts storage objects to canonical models.
+func (d Deletions) ToCanonical() []model.Deletion {
+	objs := make([]model.Deletion, 0, len(d))
+	for _, obj := range d {
+		objs = append(objs, obj.ToCanonical())
+	}
+
+	return objs
+}
@@ -1,17 +1,24 @@
 package file
 
 import (
-	"bufio"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 
 	"github.com/vstdy/go-shortener/pkg"
 	inter "github.com/vstdy/go-shortener/storage"
 	"github.com/vstdy/go-shortener/storage/file/schema"
 )
 
+const lockExt = ".lock"
+
 var _ inter.Storage = (*Storage)(nil)
 
 type (
@@ -19,15 +26,39 @@ type (
 	Storage struct {
 		sync.RWMutex
 
-		config  Config
-		file    *os.File
-		encoder *json.Encoder
-		id      int
-		urls    map[int]schema.URL
+		config             Config
+		lockFile           *os.File
+		file               *os.File
+		size               int64
+		compactedSize      int64
+		id                 int
+		urls               map[int]schema.URL
+		codes              map[string]int
+		urlIndex           map[urlKey]int
+		clickMu            sync.RWMutex
+		clickFile          *os.File
+		clickSize          int64
+		clickCompactedSize int64
+		clickID            int
+		clicks             schema.Clicks
+		delFile            *os.File
+		delRecords         int
+		deletionID         int
+		deletions          map[int]schema.Deletion
+		done               chan struct{}
+		wg                 sync.WaitGroup
+		closeOnce          sync.Once
+		closeErr           error
 	}
 
 	// StorageOption defines functional argument for Storage constructor.
 	StorageOption func(st *Storage) error
+
+	// urlKey identifies url object within its dedup scope.
+	urlKey struct {
+		dedupUserID uuid.UUID
+		url         string
+	}
 )
 
 // WithConfig overrides default Storage config.
@@ -40,6 +71,7 @@ func WithConfig(config Config) StorageOption {
 }
 
 // NewStorage creates a new file Storage with custom options.
+// Storage files are locked until the Storage is closed.
 func NewStorage(opts ...StorageOption) (*Storage, error) {
 	st := &Storage{
 		config: NewDefaultConfig(),
@@ -54,38 +86,285 @@ func NewStorage(opts ...StorageOption) (*Storage, error) {
 		return nil, fmt.Errorf("config validation: %w", err)
 	}
 
-	file, err := os.OpenFile(st.config.FileStoragePath, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+	lockFile, err := lockFile(st.config.FileStoragePath + lockExt)
 	if err != nil {
+		return nil, fmt.Errorf("locking storage: %w", err)
+	}
+	st.lockFile = lockFile
+
+	if err = st.load(); err != nil {
+		st.closeFiles()
 		return nil, err
 	}
 
+	st.done = make(chan struct{})
+	if st.config.SnapshotInterval > 0 {
+		st.wg.Add(1)
+		go st.snapshotWorker()
+	}
+	if st.config.FsyncPolicy == FsyncInterval {
+		st.wg.Add(1)
+		go st.syncWorker()
+	}
+
+	return st, nil
+}
+
+// load reads snapshot, url objects log, clicks log and deletions journal.
+func (st *Storage) load() error {
 	st.urls = make(map[int]schema.URL)
-	st.encoder = json.NewEncoder(file)
+	st.codes = make(map[string]int)
+	st.urlIndex = make(map[urlKey]int)
+
+	if err := st.loadSnapshot(); err != nil {
+		return fmt.Errorf("loading snapshot: %w", err)
+	}
+
+	file, size, err := openLog(st.config.FileStoragePath, st.loadURL)
+	if err != nil {
+		return fmt.Errorf("loading log: %w", err)
+	}
 	st.file = file
+	st.size = size
+
+	maxID := 0
+	for id := range st.urls {
+		if id > maxID {
+			maxID = id
+		}
+	}
+	st.id = maxID + 1
+
+	if err = st.loadClicks(); err != nil {
+		return fmt.Errorf("loading clicks: %w", err)
+	}
+
+	if err = st.loadDeletions(); err != nil {
+		return fmt.Errorf("loading deletions: %w", err)
+	}
+
+	return nil
+}
 
+// loadSnapshot reads url objects from snapshot file if exists.
+func (st *Storage) loadSnapshot() error {
+	file, err := os.Open(st.snapshotPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+
+	_, err = readRecords(file, st.loadURL)
+
+	return err
+}
+
+// loadURL decodes url object record, later records override earlier ones.
+func (st *Storage) loadURL(line []byte) error {
 	var urlModel schema.URL
-	for scanner := bufio.NewScanner(file); scanner.Scan(); {
-		if err := json.Unmarshal(scanner.Bytes(), &urlModel); err != nil {
-			return nil, err
+	if err := unmarshalRecord(line, &urlModel); err != nil {
+		return err
+	}
+	// records created before short codes keep resolving by numeric id
+	if urlModel.Code == "" {
+		urlModel.Code = strconv.Itoa(urlModel.ID)
+	}
+	st.putURL(urlModel)
+
+	return nil
+}
+
+// loadClicks opens clicks file and reads stored clicks.
+// Clicks out of configured retention are skipped, they are dropped from the log on its compaction.
+func (st *Storage) loadClicks() error {
+	var maxID int
+	since := time.Now().Add(-st.config.ClickRetention)
+	file, size, err := openLog(st.config.ClickStoragePath, func(line []byte) error {
+		var clickModel schema.Click
+		if err := unmarshalRecord(line, &clickModel); err != nil {
+			return err
+		}
+		if clickModel.ID > maxID {
+			maxID = clickModel.ID
 		}
-		st.urls[urlModel.ID] = urlModel
+		if st.config.ClickRetention > 0 && clickModel.CreatedAt.Before(since) {
+			return nil
+		}
+		st.clicks = append(st.clicks, clickModel)
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	st.id = urlModel.ID + 1
+	st.clickFile = file
+	st.clickSize = size
+	st.clickID = maxID + 1
 
-	return st, nil
+	return nil
 }
 
-// Close closes file.
+// Close stops storage workers and closes files.
+// Repeated calls return the result of the first one.
 func (st *Storage) Close() error {
-	if st.encoder == nil {
+	st.closeOnce.Do(func() {
+		st.closeErr = st.close()
+	})
+
+	return st.closeErr
+}
+
+// close stops storage workers, syncs and closes files.
+func (st *Storage) close() error {
+	if st.file == nil {
 		return nil
 	}
 
-	return st.file.Close()
+	close(st.done)
+	st.wg.Wait()
+
+	var err error
+	if st.config.FsyncPolicy != FsyncNever {
+		err = st.sync()
+	}
+
+	if closeErr := st.closeFiles(); err == nil {
+		err = closeErr
+	}
+
+	return err
 }
 
 // Ping implements the storage ping interface.
 func (st *Storage) Ping() error {
 	return pkg.ErrNoDBConnection
 }
+
+// closeFiles closes opened files, the lock file is closed last.
+func (st *Storage) closeFiles() error {
+	var err error
+	for _, file := range []*os.File{st.delFile, st.clickFile, st.file, st.lockFile} {
+		if file == nil {
+			continue
+		}
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+// sync commits appended records of the logs and the deletions journal to disk.
+func (st *Storage) sync() error {
+	st.RLock()
+	defer st.RUnlock()
+
+	if err := st.file.Sync(); err != nil {
+		return err
+	}
+
+	if err := st.delFile.Sync(); err != nil {
+		return err
+	}
+
+	st.clickMu.RLock()
+	defer st.clickMu.RUnlock()
+
+	return st.clickFile.Sync()
+}
+
+// syncWorker starts periodic sync worker.
+func (st *Storage) syncWorker() {
+	defer st.wg.Done()
+
+	ticker := time.NewTicker(st.config.FsyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-st.done:
+			return
+		case <-ticker.C:
+			if err := st.sync(); err != nil {
+				log.Warn().Err(err).Msg("File storage sync failed")
+			}
+		}
+	}
+}
+
+// snapshotWorker starts periodic snapshot worker.
+func (st *Storage) snapshotWorker() {
+	defer st.wg.Done()
+
+	ticker := time.NewTicker(st.config.SnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-st.done:
+			return
+		case <-ticker.C:
+			if err := st.Snapshot(); err != nil {
+				log.Warn().Err(err).Msg("File storage snapshot failed")
+			}
+		}
+	}
+}
+
+// openLog opens the log at given path for appending and reads its records.
+// A torn last record left by an interrupted write is truncated.
+// Returns the log and size of its valid records.
+func openLog(path string, decode func(line []byte) error) (*os.File, int64, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	size, err := readRecords(file, decode)
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+
+	if info.Size() > size {
+		log.Warn().Str("path", path).Int64("offset", size).Msg("Truncating torn record")
+		if err = file.Truncate(size); err != nil {
+			file.Close()
+			return nil, 0, err
+		}
+	}
+
+	return file, size, nil
+}
+
+// writeLog appends record lines to the log of given size and returns its new size.
+// On failure the log is truncated back to given size, so none of the records are persisted.
+func writeLog(file *os.File, size int64, lines []byte, sync bool) (int64, error) {
+	_, err := file.Write(lines)
+	if err == nil && sync {
+		err = file.Sync()
+	}
+	if err != nil {
+		if truncErr := file.Truncate(size); truncErr != nil {
+			return 0, fmt.Errorf("truncating log after %v: %w", err, truncErr)
+		}
+		if _, seekErr := file.Seek(size, io.SeekStart); seekErr != nil {
+			return 0, fmt.Errorf("truncating log after %v: %w", err, seekErr)
+		}
+
+		return size, err
+	}
+
+	return size + int64(len(lines)), nil
+}
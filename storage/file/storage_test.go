@@ -0,0 +1,122 @@
+package file
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+)
+
+func (s *TestSuite) TestStorage_TornTail() {
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-1.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	info, err := os.Stat(s.config.FileStoragePath)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+		tail string
+	}{
+		{
+			name: "Partial record",
+			tail: `{"crc":1234,"data":{"id":2,"co`,
+		},
+		{
+			name: "Checksum mismatch",
+			tail: `{"crc":1234,"data":{"id":2,"code":"e4F5g6H","url":"https://lengthy-url-2.com/"}}` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.appendRaw(tc.tail)
+
+			tornInfo, err := os.Stat(s.config.FileStoragePath)
+			s.Require().NoError(err)
+			s.Assert().Equal(info.Size(), tornInfo.Size())
+
+			res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+			s.Require().NoError(err)
+			s.Assert().Equal(urls[0].URL, res.URL)
+
+			res, err = s.storage.GetURL(s.ctx, "e4F5g6H")
+			s.Require().NoError(err)
+			s.Assert().EqualValues(model.URL{}, res)
+		})
+	}
+}
+
+func (s *TestSuite) TestStorage_CorruptedRecord() {
+	_, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-1.com/",
+		},
+		{
+			Code:   "e4F5g6H",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-2.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+	s.Require().NoError(s.storage.Close())
+
+	data, err := os.ReadFile(s.config.FileStoragePath)
+	s.Require().NoError(err)
+	data = []byte(strings.Replace(string(data), "lengthy-url-1", "lengthy-url-X", 1))
+	s.Require().NoError(os.WriteFile(s.config.FileStoragePath, data, 0644))
+
+	_, err = NewStorage(WithConfig(s.config))
+	s.Require().Error(err)
+	s.Assert().True(errors.Is(err, errChecksumMismatch))
+
+	// let TearDownTest close a valid storage
+	s.Require().NoError(os.Remove(s.config.FileStoragePath))
+	s.storage, err = NewStorage(WithConfig(s.config))
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestStorage_LegacyRecord() {
+	s.appendRaw(`{"id":7,"user_id":"00000000-0000-0000-0000-000000000000","url":"https://lengthy-url-1.com/"}` + "\n")
+
+	res, err := s.storage.GetURL(s.ctx, "7")
+	s.Require().NoError(err)
+	s.Assert().Equal("https://lengthy-url-1.com/", res.URL)
+}
+
+func (s *TestSuite) TestStorage_Lock() {
+	_, err := NewStorage(WithConfig(s.config))
+	s.Require().Error(err)
+	s.Assert().True(errors.Is(err, pkg.ErrStorageLocked))
+}
+
+func (s *TestSuite) TestStorage_CloseTwice() {
+	s.Require().NoError(s.storage.Close())
+	s.Require().NoError(s.storage.Close())
+}
+
+// appendRaw appends raw data to the log of closed storage and reloads it.
+func (s *TestSuite) appendRaw(data string) {
+	s.Require().NoError(s.storage.Close())
+
+	file, err := os.OpenFile(s.config.FileStoragePath, os.O_WRONLY|os.O_APPEND, 0644)
+	s.Require().NoError(err)
+	_, err = file.WriteString(data)
+	s.Require().NoError(err)
+	s.Require().NoError(file.Close())
+
+	s.storage, err = NewStorage(WithConfig(s.config))
+	s.Require().NoError(err)
+}
@@ -0,0 +1,50 @@
+package file
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	config  Config
+	storage *Storage
+
+	ctx context.Context
+}
+
+func (s *TestSuite) SetupTest() {
+	dir := s.T().TempDir()
+	s.config = NewDefaultConfig()
+	s.config.FileStoragePath = filepath.Join(dir, defaultFileStorageName)
+	s.config.ClickStoragePath = filepath.Join(dir, defaultClickStorageName)
+	s.config.DelStoragePath = filepath.Join(dir, defaultDelStorageName)
+
+	st, err := NewStorage(WithConfig(s.config))
+	s.Require().NoError(err)
+
+	s.storage = st
+	s.ctx = context.TODO()
+}
+
+func (s *TestSuite) TearDownTest() {
+	s.Require().NoError(s.storage.Close())
+}
+
+// reopen reloads storage from its files.
+func (s *TestSuite) reopen() {
+	s.Require().NoError(s.storage.Close())
+
+	st, err := NewStorage(WithConfig(s.config))
+	s.Require().NoError(err)
+
+	s.storage = st
+}
+
+func TestSuite_FileStorage(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -3,10 +3,13 @@ package file
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
 	"github.com/vstdy/go-shortener/storage/file/schema"
 )
 
@@ -15,64 +18,420 @@ func (st *Storage) HasURL(ctx context.Context, urlID int) (bool, error) {
 	st.RLock()
 	defer st.RUnlock()
 
-	_, ok := st.urls[urlID]
+	url, ok := st.urls[urlID]
 
-	return ok, nil
+	return ok && url.DeletedAt.IsZero(), nil
 }
 
 // AddURLs adds given url objects to storage
-func (st *Storage) AddURLs(ctx context.Context, objs []model.URL) ([]model.URL, error) {
+// Objects with already stored urls are not added, stored objects are returned instead
+// along with ErrAlreadyExists. Objects repeating a url within the batch share the added object.
+// With user dedup scope urls are deduplicated among objects of the same user only.
+func (st *Storage) AddURLs(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
 	st.Lock()
 	defer st.Unlock()
 
 	dbObjs := schema.NewURLsFromCanonical(objs)
+	if scope == model.DedupScopeUser {
+		for idx := range dbObjs {
+			dbObjs[idx].DedupUserID = dbObjs[idx].UserID
+		}
+	}
+
+	now := time.Now()
+	exists := false
+	isNew := make([]bool, len(dbObjs))
+	batchURLs := make(map[urlKey]bool, len(dbObjs))
+	var newObjs schema.URLS
+	for idx, obj := range dbObjs {
+		key := newURLKey(obj)
+		if _, ok := st.urlIndex[key]; ok {
+			exists = true
+			continue
+		}
+		if batchURLs[key] {
+			continue
+		}
+
+		batchURLs[key] = true
+		isNew[idx] = true
+		newObjs = append(newObjs, obj)
+	}
+
+	if err := st.checkCodes(newObjs); err != nil {
+		return nil, fmt.Errorf("file: AddURLs: %w", err)
+	}
 
+	// the batch is written at once, so a failed write leaves no object stored
+	newObjs = newObjs[:0]
 	for idx := range dbObjs {
-		dbObjs[idx].ID = st.id
+		if !isNew[idx] {
+			continue
+		}
+
+		dbObjs[idx].ID = st.id + len(newObjs)
+		if dbObjs[idx].CreatedAt.IsZero() {
+			dbObjs[idx].CreatedAt = now
+		}
+		newObjs = append(newObjs, dbObjs[idx])
+	}
+
+	if err := st.appendURLs(newObjs); err != nil {
+		return nil, fmt.Errorf("file: AddURLs: %w", err)
+	}
+
+	for _, obj := range newObjs {
+		st.putURL(obj)
+	}
+	st.id += len(newObjs)
 
-		if err := st.encoder.Encode(dbObjs[idx]); err != nil {
-			return nil, err
+	for idx := range dbObjs {
+		if isNew[idx] {
+			continue
 		}
 
-		st.urls[dbObjs[idx].ID] = dbObjs[idx]
-		st.id++
+		storedObj := st.urls[st.urlIndex[newURLKey(dbObjs[idx])]]
+		storedObj.CorrelationID = dbObjs[idx].CorrelationID
+		dbObjs[idx] = storedObj
 	}
 
 	addedObjs := dbObjs.ToCanonical()
 
+	if exists {
+		return addedObjs, fmt.Errorf("file: AddURLs: %w", pkg.ErrAlreadyExists)
+	}
+
 	return addedObjs, nil
 }
 
-// GetURL gets url object with given id
-func (st *Storage) GetURL(ctx context.Context, urlID int) (model.URL, error) {
+// GetURL gets url object with given short code
+func (st *Storage) GetURL(ctx context.Context, code string) (model.URL, error) {
 	st.RLock()
 	defer st.RUnlock()
 
-	url, ok := st.urls[urlID]
+	url, ok := st.liveURL(code)
 	if !ok {
-		return model.URL{}, fmt.Errorf("url does not exist")
+		return model.URL{}, nil
 	}
 
 	return url.ToCanonical(), nil
 }
 
-// GetUsersURLs gets current user url objects
-func (st *Storage) GetUsersURLs(ctx context.Context, userID uuid.UUID) ([]model.URL, error) {
+// RedeemURL counts a click of the url object with given short code
+// Objects out of clicks or expired by the time of redeem are not counted.
+// Updated object is appended to the file and overrides the previous record on load.
+func (st *Storage) RedeemURL(ctx context.Context, code string) (model.URL, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	url, ok := st.liveURL(code)
+	if !ok || url.MaxClicks > 0 && url.Clicks >= url.MaxClicks ||
+		!url.ExpiresAt.IsZero() && !url.ExpiresAt.After(time.Now()) {
+		return model.URL{}, nil
+	}
+
+	url.Clicks++
+
+	if err := st.appendURL(url); err != nil {
+		return model.URL{}, fmt.Errorf("file: RedeemURL: %w", err)
+	}
+	st.putURL(url)
+
+	return url.ToCanonical(), nil
+}
+
+// GetUsersURLs gets current user url objects matching given query
+func (st *Storage) GetUsersURLs(ctx context.Context, userID uuid.UUID, query model.URLsQuery) ([]model.URL, error) {
 	st.RLock()
 	defer st.RUnlock()
 
+	var urls []model.URL
+	for _, v := range st.urls {
+		if v.UserID != userID || !v.DeletedAt.IsZero() {
+			continue
+		}
+
+		url := v.ToCanonical()
+		if query.Filter.Match(url) && query.IsAfter(url) {
+			urls = append(urls, url)
+		}
+	}
+
+	sort.Slice(urls, func(i, j int) bool {
+		return query.Less(urls[i], urls[j])
+	})
+	if len(urls) > query.Limit {
+		urls = urls[:query.Limit]
+	}
+
+	return urls, nil
+}
+
+// UpdateURL updates current user url object with given short code
+// Updated object is appended to the file and overrides the previous record on load.
+func (st *Storage) UpdateURL(ctx context.Context, obj model.URL) (model.URL, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	url, ok := st.liveURL(obj.Code)
+	if !ok || url.UserID != obj.UserID {
+		return model.URL{}, nil
+	}
+
+	if obj.URL != "" {
+		if obj.URL != url.URL {
+			if _, ok := st.urlIndex[urlKey{dedupUserID: url.DedupUserID, url: obj.URL}]; ok {
+				return model.URL{}, fmt.Errorf("file: UpdateURL: %w", pkg.ErrAlreadyExists)
+			}
+		}
+
+		url.URL = obj.URL
+		url.InputURL = obj.InputURL
+	}
+	if obj.RedirectCode != 0 {
+		url.RedirectCode = obj.RedirectCode
+	}
+
+	if err := st.appendURL(url); err != nil {
+		return model.URL{}, fmt.Errorf("file: UpdateURL: %w", err)
+	}
+
+	st.putURL(url)
+
+	return url.ToCanonical(), nil
+}
+
+// GetUsersDeletedURLs gets current user url objects deleted since given time
+func (st *Storage) GetUsersDeletedURLs(ctx context.Context, userID uuid.UUID, since time.Time) ([]model.URL, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	now := time.Now()
+
 	var urls schema.URLS
 	for _, v := range st.urls {
-		if v.UserID == userID {
+		if v.UserID == userID && isRestorable(v, since, now) {
 			urls = append(urls, v)
 		}
 	}
 
+	sort.Slice(urls, func(i, j int) bool {
+		return urls[i].DeletedAt.After(urls[j].DeletedAt)
+	})
+
 	return urls.ToCanonical(), nil
 }
 
-// RemoveUsersURLs removes current user url objects with given ids
-func (st *Storage) RemoveUsersURLs(ctx context.Context, objs []model.URL) error {
+// RestoreURL restores current user url object with given short code deleted since given time
+// Restored object is appended to the file and overrides the tombstone record on load.
+func (st *Storage) RestoreURL(ctx context.Context, obj model.URL, since time.Time) (model.URL, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	url, ok := st.urls[st.codes[obj.Code]]
+	if !ok || url.UserID != obj.UserID || !isRestorable(url, since, time.Now()) {
+		return model.URL{}, nil
+	}
+
+	if _, ok := st.urlIndex[newURLKey(url)]; ok {
+		return model.URL{}, fmt.Errorf("file: RestoreURL: %w", pkg.ErrAlreadyExists)
+	}
+
+	url.DeletedAt = time.Time{}
+
+	if err := st.appendURL(url); err != nil {
+		return model.URL{}, fmt.Errorf("file: RestoreURL: %w", err)
+	}
+
+	st.putURL(url)
+
+	return url.ToCanonical(), nil
+}
+
+// RemoveUsersURLs removes current user url objects with given short codes
+// Removed objects are appended to the file as tombstone records with deletion time set at once,
+// their short codes stay taken. Objects already removed by the user are reported deleted.
+func (st *Storage) RemoveUsersURLs(ctx context.Context, objs []model.URL) ([]model.DeletionStatus, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	now := time.Now()
+	statuses := make([]model.DeletionStatus, 0, len(objs))
+	removed := make(map[int]bool, len(objs))
+	var tombstones schema.URLS
+	for _, obj := range objs {
+		url, ok := st.urls[st.codes[obj.Code]]
+		status := deletionStatus(url, ok, obj.UserID)
+		statuses = append(statuses, status)
+		if status != model.DeletionDeleted || !url.DeletedAt.IsZero() || removed[url.ID] {
+			continue
+		}
+
+		url.DeletedAt = now
+		removed[url.ID] = true
+		tombstones = append(tombstones, url)
+	}
+
+	if err := st.appendURLs(tombstones); err != nil {
+		return nil, fmt.Errorf("file: RemoveUsersURLs: %w", err)
+	}
+
+	for _, url := range tombstones {
+		st.putURL(url)
+	}
+
+	return statuses, nil
+}
+
+// RemoveExpiredURLs removes url objects expired by given time
+// Expired objects are appended to the file as tombstone records at once, their short codes stay taken.
+func (st *Storage) RemoveExpiredURLs(ctx context.Context, before time.Time) (int, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	var tombstones schema.URLS
+	for _, url := range st.urls {
+		if url.DeletedAt.IsZero() && !url.ExpiresAt.IsZero() && !url.ExpiresAt.After(before) {
+			url.DeletedAt = before
+			tombstones = append(tombstones, url)
+		}
+	}
+
+	if err := st.appendURLs(tombstones); err != nil {
+		return 0, fmt.Errorf("file: RemoveExpiredURLs: %w", err)
+	}
+
+	for _, url := range tombstones {
+		st.putURL(url)
+	}
+
+	return len(tombstones), nil
+}
+
+// ListURLs gets at most limit url objects with id greater than afterID ordered by id
+// Removed objects are listed as well.
+func (st *Storage) ListURLs(ctx context.Context, afterID, limit int) ([]model.URL, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	var urls schema.URLS
+	for _, v := range st.urls {
+		if v.ID > afterID {
+			urls = append(urls, v)
+		}
+	}
+
+	sort.Slice(urls, func(i, j int) bool {
+		return urls[i].ID < urls[j].ID
+	})
+	if len(urls) > limit {
+		urls = urls[:limit]
+	}
+
+	return urls.ToCanonical(), nil
+}
+
+// ImportURLs adds given url objects to storage keeping their ids
+// Objects with already stored ids are skipped, so an interrupted import can be repeated.
+// The batch is written at once, a failed write leaves none of the objects stored.
+func (st *Storage) ImportURLs(ctx context.Context, objs []model.URL) error {
+	st.Lock()
+	defer st.Unlock()
+
+	var newObjs schema.URLS
+	batchURLs := make(map[urlKey]bool, len(objs))
+	for _, obj := range schema.NewURLsFromCanonical(objs) {
+		if _, ok := st.urls[obj.ID]; ok {
+			continue
+		}
+		if obj.DeletedAt.IsZero() {
+			key := newURLKey(obj)
+			if _, ok := st.urlIndex[key]; ok || batchURLs[key] {
+				return fmt.Errorf("file: ImportURLs: %w", pkg.ErrAlreadyExists)
+			}
+			batchURLs[key] = true
+		}
+
+		newObjs = append(newObjs, obj)
+	}
+
+	if err := st.checkCodes(newObjs); err != nil {
+		return fmt.Errorf("file: ImportURLs: %w", err)
+	}
+
+	if err := st.appendURLs(newObjs); err != nil {
+		return fmt.Errorf("file: ImportURLs: %w", err)
+	}
+
+	for _, obj := range newObjs {
+		st.putURL(obj)
+		if obj.ID >= st.id {
+			st.id = obj.ID + 1
+		}
+	}
+
+	return nil
+}
+
+// checkCodes checks short codes of given objects are not taken.
+func (st *Storage) checkCodes(objs schema.URLS) error {
+	batchCodes := make(map[string]bool, len(objs))
+	for _, obj := range objs {
+		if _, ok := st.codes[obj.Code]; ok || batchCodes[obj.Code] {
+			return pkg.ErrCodeTaken
+		}
+		batchCodes[obj.Code] = true
+	}
 
 	return nil
 }
+
+// putURL stores url object keeping short codes and urls indexes in sync.
+// Only not removed objects are indexed by url within their dedup scope.
+func (st *Storage) putURL(url schema.URL) {
+	if prevURL, ok := st.urls[url.ID]; ok && st.urlIndex[newURLKey(prevURL)] == url.ID {
+		delete(st.urlIndex, newURLKey(prevURL))
+	}
+
+	st.urls[url.ID] = url
+	st.codes[url.Code] = url.ID
+	if url.DeletedAt.IsZero() {
+		st.urlIndex[newURLKey(url)] = url.ID
+	}
+}
+
+// newURLKey creates urls index key of the url object.
+func newURLKey(url schema.URL) urlKey {
+	return urlKey{dedupUserID: url.DedupUserID, url: url.URL}
+}
+
+// deletionStatus returns the outcome of the url object deletion by given user.
+// Objects removed by other users are not found.
+func deletionStatus(url schema.URL, ok bool, userID uuid.UUID) model.DeletionStatus {
+	switch {
+	case !ok:
+		return model.DeletionNotFound
+	case url.UserID == userID:
+		return model.DeletionDeleted
+	case url.DeletedAt.IsZero():
+		return model.DeletionNotOwned
+	default:
+		return model.DeletionNotFound
+	}
+}
+
+// liveURL gets not removed url object with given short code.
+func (st *Storage) liveURL(code string) (schema.URL, bool) {
+	url, ok := st.urls[st.codes[code]]
+	if !ok || !url.DeletedAt.IsZero() {
+		return schema.URL{}, false
+	}
+
+	return url, true
+}
+
+// isRestorable checks the url object is removed since given time and not expired.
+func isRestorable(url schema.URL, since, now time.Time) bool {
+	return !url.DeletedAt.IsZero() && !url.DeletedAt.Before(since) &&
+		(url.ExpiresAt.IsZero() || url.ExpiresAt.After(now))
+}
@@ -0,0 +1,453 @@
+package file
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+)
+
+func (s *TestSuite) TestURLs_AddURLs() {
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-1.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	s.Run("Add already shortened url", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "e4F5g6H",
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Require().Len(res, 1)
+		s.Assert().Equal(urls[0].ID, res[0].ID)
+		s.Assert().Equal(urls[0].Code, res[0].Code)
+	})
+
+	s.Run("Add mixed batch", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:          "i7J8k9L",
+				CorrelationID: "1",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-2.com/",
+			},
+			{
+				Code:          "a1B2c3D",
+				CorrelationID: "2",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Require().Len(res, 2)
+		s.Assert().Equal("1", res[0].CorrelationID)
+		s.Assert().Equal("i7J8k9L", res[0].Code)
+		s.Assert().Equal("2", res[1].CorrelationID)
+		s.Assert().Equal(urls[0].ID, res[1].ID)
+
+		added, err := s.storage.GetURL(s.ctx, "i7J8k9L")
+		s.Require().NoError(err)
+		s.Assert().Equal("https://lengthy-url-2.com/", added.URL)
+	})
+
+	s.Run("Add batch repeating url", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:          "q6R7s8T",
+				CorrelationID: "1",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-3.com/",
+			},
+			{
+				Code:          "u9V0w1X",
+				CorrelationID: "2",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-3.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		s.Assert().Equal(res[0].ID, res[1].ID)
+		s.Assert().Equal("q6R7s8T", res[1].Code)
+		s.Assert().Equal("2", res[1].CorrelationID)
+	})
+
+	s.Run("Update url to already shortened one", func() {
+		_, err := s.storage.UpdateURL(s.ctx, model.URL{
+			Code:   urls[0].Code,
+			UserID: urls[0].UserID,
+			URL:    "https://lengthy-url-2.com/",
+		})
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+	})
+
+	s.Run("Add url of removed one", func() {
+		_, err := s.storage.RemoveUsersURLs(s.ctx, urls)
+		s.Require().NoError(err)
+
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "y2Z3a4B",
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+		s.Assert().NotEqual(urls[0].ID, res[0].ID)
+
+		_, err = s.storage.RestoreURL(s.ctx, urls[0], time.Now().Add(-time.Hour))
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+	})
+
+	s.Run("Url index survives restart", func() {
+		s.reopen()
+
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "m3N4o5P",
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Assert().Equal("y2Z3a4B", res[0].Code)
+	})
+}
+
+func (s *TestSuite) TestURLs_AddURLsWriteFailure() {
+	logFile := s.storage.file
+	defer func() { s.storage.file = logFile }()
+
+	readOnly, err := os.Open(s.config.FileStoragePath)
+	s.Require().NoError(err)
+	defer readOnly.Close()
+	s.storage.file = readOnly
+
+	_, err = s.storage.AddURLs(s.ctx, []model.URL{
+		{Code: "a1B2c3D", UserID: uuid.New(), URL: "https://lengthy-url-1.com/"},
+		{Code: "e4F5g6H", UserID: uuid.New(), URL: "https://lengthy-url-2.com/"},
+	}, model.DedupScopeGlobal)
+	s.Require().Error(err)
+
+	for _, code := range []string{"a1B2c3D", "e4F5g6H"} {
+		url, err := s.storage.GetURL(s.ctx, code)
+		s.Require().NoError(err)
+		s.Assert().Zero(url)
+	}
+
+	s.storage.file = logFile
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{Code: "a1B2c3D", UserID: uuid.New(), URL: "https://lengthy-url-1.com/"},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+	s.Assert().Equal(1, urls[0].ID)
+}
+
+func (s *TestSuite) TestURLs_ImportURLsWriteFailure() {
+	objs := []model.URL{
+		{ID: 3, Code: "a1B2c3D", UserID: uuid.New(), URL: "https://lengthy-url-1.com/"},
+		{ID: 7, Code: "e4F5g6H", UserID: uuid.New(), URL: "https://lengthy-url-2.com/"},
+	}
+
+	logFile := s.storage.file
+	readOnly, err := os.Open(s.config.FileStoragePath)
+	s.Require().NoError(err)
+	defer readOnly.Close()
+	s.storage.file = readOnly
+
+	s.Require().Error(s.storage.ImportURLs(s.ctx, objs))
+	s.storage.file = logFile
+
+	urls, err := s.storage.ListURLs(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	s.Assert().Empty(urls)
+
+	s.Require().NoError(s.storage.ImportURLs(s.ctx, objs))
+	s.reopen()
+
+	urls, err = s.storage.ListURLs(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	s.Require().Len(urls, 2)
+	s.Assert().Equal(7, urls[1].ID)
+}
+
+func (s *TestSuite) TestURLs_RemoveUserURLsWriteFailure() {
+	userID := uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{Code: "a1B2c3D", UserID: userID, URL: "https://lengthy-url-1.com/"},
+		{Code: "e4F5g6H", UserID: userID, URL: "https://lengthy-url-2.com/"},
+		{Code: "i7J8k9L", UserID: userID, URL: "https://lengthy-url-3.com/", ExpiresAt: time.Now().Add(time.Minute)},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	logFile := s.storage.file
+	readOnly, err := os.Open(s.config.FileStoragePath)
+	s.Require().NoError(err)
+	defer readOnly.Close()
+	s.storage.file = readOnly
+
+	_, err = s.storage.RemoveUsersURLs(s.ctx, urls)
+	s.Require().Error(err)
+	_, err = s.storage.RemoveExpiredURLs(s.ctx, time.Now().Add(time.Hour))
+	s.Require().Error(err)
+	s.storage.file = logFile
+
+	for _, url := range urls {
+		res, err := s.storage.GetURL(s.ctx, url.Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(url.ID, res.ID)
+	}
+}
+
+func (s *TestSuite) TestURLs_RedeemURL() {
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{Code: "a1B2c3D", UserID: uuid.New(), URL: "https://lengthy-url-1.com/", MaxClicks: 1},
+		{
+			Code:      "e4F5g6H",
+			UserID:    uuid.New(),
+			URL:       "https://lengthy-url-2.com/",
+			MaxClicks: 5,
+			ExpiresAt: time.Now().Add(-time.Minute),
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	res, err := s.storage.RedeemURL(s.ctx, urls[0].Code)
+	s.Require().NoError(err)
+	s.Assert().Equal(1, res.Clicks)
+
+	for _, url := range urls {
+		res, err = s.storage.RedeemURL(s.ctx, url.Code)
+		s.Require().NoError(err)
+		s.Assert().Zero(res, url.Code)
+	}
+}
+
+func (s *TestSuite) TestURLs_AddURLsUserScope() {
+	userA, userB := uuid.New(), uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: userA,
+			URL:    "https://lengthy-url-1.com/",
+		},
+	}, model.DedupScopeUser)
+	s.Require().NoError(err)
+
+	s.Run("Add url shortened by another user", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "e4F5g6H",
+				UserID: userB,
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeUser)
+		s.Require().NoError(err)
+		s.Assert().Equal("e4F5g6H", res[0].Code)
+		s.Assert().Equal(userB, res[0].UserID)
+
+		usersURLs, err := s.storage.GetUsersURLs(s.ctx, userB, model.URLsQuery{Limit: 100})
+		s.Require().NoError(err)
+		s.Require().Len(usersURLs, 1)
+		s.Assert().Equal("e4F5g6H", usersURLs[0].Code)
+	})
+
+	s.Run("Add url shortened by the same user", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "i7J8k9L",
+				UserID: userA,
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeUser)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Assert().Equal(urls[0].ID, res[0].ID)
+	})
+
+	s.Run("Add url shortened by a user with global scope", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "m3N4o5P",
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+		s.Assert().Equal("m3N4o5P", res[0].Code)
+	})
+
+	s.Run("Url scope survives restart", func() {
+		s.reopen()
+
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "q6R7s8T",
+				UserID: userB,
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeUser)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Assert().Equal("e4F5g6H", res[0].Code)
+	})
+}
+
+func (s *TestSuite) TestURLs_RemoveUserURLs() {
+	userID := uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: userID,
+			URL:    "https://lengthy-url-1.com/",
+		},
+		{
+			Code:   "e4F5g6H",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-2.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	s.Run("Remove urls by creator", func() {
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, []model.URL{{Code: urls[0].Code, UserID: userID}})
+		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{model.DeletionDeleted}, statuses)
+
+		res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Remove urls by non-creator", func() {
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, []model.URL{{Code: urls[1].Code, UserID: userID}})
+		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{model.DeletionNotOwned}, statuses)
+
+		res, err := s.storage.GetURL(s.ctx, urls[1].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(urls[1].URL, res.URL)
+	})
+
+	s.Run("Remove removed and missing urls", func() {
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, []model.URL{
+			{Code: urls[0].Code, UserID: userID},
+			{Code: urls[0].Code, UserID: uuid.New()},
+			{Code: "m1N2o3P", UserID: userID},
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{
+			model.DeletionDeleted, model.DeletionNotFound, model.DeletionNotFound,
+		}, statuses)
+	})
+
+	s.Run("Removal survives restart", func() {
+		s.reopen()
+
+		res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+
+		userURLs, err := s.storage.GetUsersURLs(s.ctx, userID, model.URLsQuery{Limit: 100})
+		s.Require().NoError(err)
+		s.Assert().Empty(userURLs)
+
+		res, err = s.storage.GetURL(s.ctx, urls[1].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(urls[1].URL, res.URL)
+	})
+}
+
+func (s *TestSuite) TestURLs_RestoreURL() {
+	userID := uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: userID,
+			URL:    "https://lengthy-url-1.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	since := time.Now().Add(-time.Hour)
+	_, err = s.storage.RemoveUsersURLs(s.ctx, urls)
+	s.Require().NoError(err)
+
+	s.Run("Get deleted urls after restart", func() {
+		s.reopen()
+
+		res, err := s.storage.GetUsersDeletedURLs(s.ctx, userID, since)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(urls[0].Code, res[0].Code)
+	})
+
+	s.Run("Restore survives restart", func() {
+		res, err := s.storage.RestoreURL(s.ctx, urls[0], since)
+		s.Require().NoError(err)
+		s.Assert().Equal(urls[0].URL, res.URL)
+
+		s.reopen()
+
+		res, err = s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(urls[0].URL, res.URL)
+		s.Assert().True(res.DeletedAt.IsZero())
+	})
+}
+
+func (s *TestSuite) TestURLs_UpdateURL() {
+	userID := uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:     "a1B2c3D",
+			UserID:   userID,
+			URL:      "https://lengthy-url-1.com/",
+			InputURL: "HTTPS://Lengthy-URL-1.com:443/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	s.Run("Input url survives restart", func() {
+		s.reopen()
+
+		res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal("https://lengthy-url-1.com/", res.URL)
+		s.Assert().Equal("HTTPS://Lengthy-URL-1.com:443/", res.InputURL)
+	})
+
+	s.Run("Input url is updated along with url", func() {
+		res, err := s.storage.UpdateURL(s.ctx, model.URL{
+			Code:     urls[0].Code,
+			UserID:   userID,
+			URL:      "https://lengthy-url-1.com/",
+			InputURL: "https://lengthy-url-1.com/?utm_source=mail",
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal("https://lengthy-url-1.com/?utm_source=mail", res.InputURL)
+
+		s.reopen()
+
+		res, err = s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal("https://lengthy-url-1.com/?utm_source=mail", res.InputURL)
+	})
+}
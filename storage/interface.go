@@ -4,6 +4,7 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -15,14 +16,63 @@ type Storage interface {
 
 	// HasURL checks existence of the object with given id
 	HasURL(ctx context.Context, urlID int) (bool, error)
-	// AddURLs adds given objects to storage
-	AddURLs(ctx context.Context, objs []model.URL) ([]model.URL, error)
-	// GetURL gets object with given id
-	GetURL(ctx context.Context, urlID int) (model.URL, error)
-	// GetUsersURLs gets current user objects
-	GetUsersURLs(ctx context.Context, userID uuid.UUID) ([]model.URL, error)
-	// RemoveUsersURLs removes current user objects with given ids
-	RemoveUsersURLs(ctx context.Context, objs []model.URL) error
+	// AddURLs adds given objects to storage,
+	// objects with urls already stored within given dedup scope are returned instead with ErrAlreadyExists,
+	// objects repeating a url within the batch share the first of them
+	AddURLs(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error)
+	// GetURL gets object with given short code
+	GetURL(ctx context.Context, code string) (model.URL, error)
+	// RedeemURL counts a click of the object with given short code,
+	// objects out of clicks or expired are not returned
+	RedeemURL(ctx context.Context, code string) (model.URL, error)
+	// GetUsersURLs gets at most query limit current user objects matching query filter,
+	// listed after query position in query order
+	GetUsersURLs(ctx context.Context, userID uuid.UUID, query model.URLsQuery) ([]model.URL, error)
+	// UpdateURL updates url and redirect code of current user object with given short code,
+	// zero fields are left unchanged, objects of other users are not returned
+	UpdateURL(ctx context.Context, obj model.URL) (model.URL, error)
+	// GetUsersDeletedURLs gets current user objects deleted since given time
+	GetUsersDeletedURLs(ctx context.Context, userID uuid.UUID, since time.Time) ([]model.URL, error)
+	// RestoreURL restores current user object with given short code deleted since given time,
+	// objects of other users are not returned
+	RestoreURL(ctx context.Context, obj model.URL, since time.Time) (model.URL, error)
+	// RemoveUsersURLs removes current user objects with given short codes,
+	// deletion status of every given object is returned
+	RemoveUsersURLs(ctx context.Context, objs []model.URL) ([]model.DeletionStatus, error)
+	// AddDeletions adds given pending deletion objects to storage
+	AddDeletions(ctx context.Context, objs []model.Deletion) ([]model.Deletion, error)
+	// ClaimDueDeletions gets at most limit pending deletion objects to be attempted by given time ordered by id
+	// and postpones their next attempt by given lease, so concurrent callers don't get the same objects
+	ClaimDueDeletions(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]model.Deletion, error)
+	// HasPendingDeletions checks whether any deletion object is pending regardless of its next attempt time,
+	// dead objects are not pending
+	HasPendingDeletions(ctx context.Context) (bool, error)
+	// GetJobDeletions gets deletion objects of the job with given id ordered by id
+	GetJobDeletions(ctx context.Context, jobID uuid.UUID) ([]model.Deletion, error)
+	// UpdateDeletions updates status, attempts, last error, next attempt, done and dead time of given deletion objects
+	UpdateDeletions(ctx context.Context, objs []model.Deletion) error
+	// RemoveDoneDeletions removes deletion objects done before given time
+	RemoveDoneDeletions(ctx context.Context, before time.Time) (int, error)
+	// GetDeadDeletions gets at most limit dead deletion objects with id greater than afterID ordered by id
+	GetDeadDeletions(ctx context.Context, afterID, limit int) ([]model.Deletion, error)
+	// RemoveExpiredURLs removes objects expired by given time
+	RemoveExpiredURLs(ctx context.Context, before time.Time) (int, error)
+	// ListURLs gets at most limit objects with id greater than afterID ordered by id,
+	// removed objects are listed as well
+	ListURLs(ctx context.Context, afterID, limit int) ([]model.URL, error)
+	// ImportURLs adds given objects to storage keeping their ids,
+	// objects with already stored ids are skipped
+	ImportURLs(ctx context.Context, objs []model.URL) error
+	// AddClicks adds given click objects to storage
+	AddClicks(ctx context.Context, objs []model.Click) error
+	// GetClickStats gets statistics of the url clicks made in [from, to) time range
+	// with at most topLimit top counters, buckets without clicks are omitted
+	GetClickStats(ctx context.Context, urlID int, params model.StatsParams, topLimit int) (model.URLStats, error)
+	// ListClicks gets at most limit click objects with id greater than afterID ordered by id
+	ListClicks(ctx context.Context, afterID, limit int) ([]model.Click, error)
+	// ImportClicks adds given click objects to storage keeping their ids,
+	// objects with already stored ids are skipped
+	ImportClicks(ctx context.Context, objs []model.Click) error
 	// Ping verifies a connection to the database is still alive.
 	Ping() error
 }
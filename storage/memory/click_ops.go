@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/vstdy/go-shortener/model"
+	inter "github.com/vstdy/go-shortener/storage"
+	"github.com/vstdy/go-shortener/storage/memory/schema"
+)
+
+// AddClicks adds given click objects to storage
+func (st *Storage) AddClicks(ctx context.Context, objs []model.Click) error {
+	st.Lock()
+	defer st.Unlock()
+
+	dbObjs := schema.NewClicksFromCanonical(objs)
+	for idx := range dbObjs {
+		dbObjs[idx].ID = st.clickID
+		st.clickID++
+	}
+	st.clicks = append(st.clicks, dbObjs...)
+
+	return nil
+}
+
+// GetClickStats gets statistics of the url clicks made in [from, to) time range
+func (st *Storage) GetClickStats(
+	ctx context.Context, urlID int, params model.StatsParams, topLimit int) (
+	model.URLStats, error) {
+
+	st.RLock()
+	defer st.RUnlock()
+
+	var clicks schema.Clicks
+	for _, click := range st.clicks {
+		if click.URLID == urlID && !click.CreatedAt.Before(params.From) && click.CreatedAt.Before(params.To) {
+			clicks = append(clicks, click)
+		}
+	}
+
+	return inter.AggregateClicks(clicks.ToCanonical(), params.Bucket, topLimit), nil
+}
+
+// ListClicks gets at most limit click objects with id greater than afterID ordered by id
+func (st *Storage) ListClicks(ctx context.Context, afterID, limit int) ([]model.Click, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	var clicks schema.Clicks
+	for _, click := range st.clicks {
+		if click.ID > afterID {
+			clicks = append(clicks, click)
+		}
+	}
+
+	sort.Slice(clicks, func(i, j int) bool {
+		return clicks[i].ID < clicks[j].ID
+	})
+	if len(clicks) > limit {
+		clicks = clicks[:limit]
+	}
+
+	return clicks.ToCanonical(), nil
+}
+
+// ImportClicks adds given click objects to storage keeping their ids
+// Objects with already stored ids are skipped, so an interrupted import can be repeated.
+func (st *Storage) ImportClicks(ctx context.Context, objs []model.Click) error {
+	st.Lock()
+	defer st.Unlock()
+
+	ids := make(map[int]bool, len(st.clicks))
+	for _, click := range st.clicks {
+		ids[click.ID] = true
+	}
+
+	for _, click := range schema.NewClicksFromCanonical(objs) {
+		if ids[click.ID] {
+			continue
+		}
+
+		st.clicks = append(st.clicks, click)
+		ids[click.ID] = true
+		if click.ID >= st.clickID {
+			st.clickID = click.ID + 1
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/storage/memory/schema"
+)
+
+// AddDeletions adds given pending deletion objects to storage
+func (st *Storage) AddDeletions(ctx context.Context, objs []model.Deletion) ([]model.Deletion, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	dbObjs := schema.NewDeletionsFromCanonical(objs)
+	for idx := range dbObjs {
+		dbObjs[idx].ID = st.deletionID
+		if dbObjs[idx].CreatedAt.IsZero() {
+			dbObjs[idx].CreatedAt = time.Now()
+		}
+		if dbObjs[idx].Status == "" {
+			dbObjs[idx].Status = model.DeletionPending
+		}
+
+		st.deletions[dbObjs[idx].ID] = dbObjs[idx]
+		st.deletionID++
+	}
+
+	return dbObjs.ToCanonical(), nil
+}
+
+// ClaimDueDeletions gets at most limit pending deletion objects to be attempted by given time ordered by id
+// Next attempt of the objects is postponed by given lease, so they are not claimed again until it expires.
+func (st *Storage) ClaimDueDeletions(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]model.Deletion, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	deletions := st.listDeletions(limit, func(deletion schema.Deletion) bool {
+		return deletion.Status == model.DeletionPending && deletion.DeadAt.IsZero() &&
+			!deletion.NextAttemptAt.After(now)
+	})
+
+	for idx := range deletions {
+		deletions[idx].NextAttemptAt = now.Add(lease)
+
+		deletion := st.deletions[deletions[idx].ID]
+		deletion.NextAttemptAt = deletions[idx].NextAttemptAt
+		st.deletions[deletion.ID] = deletion
+	}
+
+	return deletions, nil
+}
+
+// HasPendingDeletions checks whether any deletion object is pending regardless of its next attempt time
+func (st *Storage) HasPendingDeletions(ctx context.Context) (bool, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	for _, deletion := range st.deletions {
+		if deletion.Status == model.DeletionPending && deletion.DeadAt.IsZero() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetJobDeletions gets deletion objects of the job with given id ordered by id
+func (st *Storage) GetJobDeletions(ctx context.Context, jobID uuid.UUID) ([]model.Deletion, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	return st.listDeletions(len(st.deletions), func(deletion schema.Deletion) bool {
+		return deletion.JobID == jobID
+	}), nil
+}
+
+// UpdateDeletions updates status, attempts, last error, next attempt, done and dead time of given deletion objects
+// Objects missing in storage are skipped.
+func (st *Storage) UpdateDeletions(ctx context.Context, objs []model.Deletion) error {
+	st.Lock()
+	defer st.Unlock()
+
+	for _, obj := range schema.NewDeletionsFromCanonical(objs) {
+		deletion, ok := st.deletions[obj.ID]
+		if !ok {
+			continue
+		}
+
+		deletion.Status = obj.Status
+		deletion.Attempts = obj.Attempts
+		deletion.LastError = obj.LastError
+		deletion.NextAttemptAt = obj.NextAttemptAt
+		deletion.DoneAt = obj.DoneAt
+		deletion.DeadAt = obj.DeadAt
+		st.deletions[obj.ID] = deletion
+	}
+
+	return nil
+}
+
+// RemoveDoneDeletions removes deletion objects done before given time
+func (st *Storage) RemoveDoneDeletions(ctx context.Context, before time.Time) (int, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	cnt := 0
+	for id, deletion := range st.deletions {
+		if !deletion.DoneAt.IsZero() && deletion.DoneAt.Before(before) {
+			delete(st.deletions, id)
+			cnt++
+		}
+	}
+
+	return cnt, nil
+}
+
+// GetDeadDeletions gets at most limit dead deletion objects with id greater than afterID ordered by id
+func (st *Storage) GetDeadDeletions(ctx context.Context, afterID, limit int) ([]model.Deletion, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	return st.listDeletions(limit, func(deletion schema.Deletion) bool {
+		return deletion.ID > afterID && !deletion.DeadAt.IsZero()
+	}), nil
+}
+
+// listDeletions returns at most limit deletion objects matching given filter ordered by id.
+func (st *Storage) listDeletions(limit int, match func(deletion schema.Deletion) bool) []model.Deletion {
+	var deletions schema.Deletions
+	for _, deletion := range st.deletions {
+		if match(deletion) {
+			deletions = append(deletions, deletion)
+		}
+	}
+
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i].ID < deletions[j].ID
+	})
+	if len(deletions) > limit {
+		deletions = deletions[:limit]
+	}
+
+	return deletions.ToCanonical()
+}
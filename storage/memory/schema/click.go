@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+type (
+	Click struct {
+		ID        int
+		URLID     int
+		Referrer  string
+		UserAgent string
+		IP        string
+		CreatedAt time.Time
+	}
+
+	Clicks []Click
+)
+
+// NewClicksFromCanonical creates new list of Click storage objects from canonical model.
+func NewClicksFromCanonical(objs []model.Click) Clicks {
+	var clicks Clicks
+	for _, click := range objs {
+		clicks = append(clicks, Click{
+			ID:        click.ID,
+			URLID:     click.URLID,
+			Referrer:  click.Referrer,
+			UserAgent: click.UserAgent,
+			IP:        click.IP,
+			CreatedAt: click.CreatedAt,
+		})
+	}
+
+	return clicks
+}
+
+// ToCanonical converts a storage object to canonical model.
+func (c Click) ToCanonical() model.Click {
+	return model.Click{
+		ID:        c.ID,
+		URLID:     c.URLID,
+		Referrer:  c.Referrer,
+		UserAgent: c.UserAgent,
+		IP:        c.IP,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+// ToCanonical converts storage objects to canonical models.
+func (c Clicks) ToCanonical() []model.Click {
+	objs := make([]model.Click, 0, len(c))
+	for _, obj := range c {
+		objs = append(objs, obj.ToCanonical())
+	}
+
+	return objs
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+type (
+	Deletion struct {
+		ID            int
+		JobID         uuid.UUID
+		UserID        uuid.UUID
+		Code          string
+		Status        model.DeletionStatus
+		Attempts      int
+		LastError     string
+		NextAttemptAt time.Time
+		CreatedAt     time.Time
+		DoneAt        time.Time
+		DeadAt        time.Time
+	}
+
+	Deletions []Deletion
+)
+
+// NewDeletionsFromCanonical creates new list of Deletion storage objects from canonical model.
+func NewDeletionsFromCanonical(objs []model.Deletion) Deletions {
+	var deletions Deletions
+	for _, deletion := range objs {
+		deletions = append(deletions, Deletion{
+			ID:            deletion.ID,
+			JobID:         deletion.JobID,
+			UserID:        deletion.UserID,
+			Code:          deletion.Code,
+			Status:        deletion.Status,
+			Attempts:      deletion.Attempts,
+			LastError:     deletion.LastError,
+			NextAttemptAt: deletion.NextAttemptAt,
+			CreatedAt:     deletion.CreatedAt,
+			DoneAt:        deletion.DoneAt,
+			DeadAt:        deletion.DeadAt,
+		})
+	}
+
+	return deletions
+}
+
+// ToCanonical converts a storage object to canonical model.
+func (d Deletion) ToCanonical() model.Deletion {
+	return model.Deletion{
+		ID:            d.ID,
+		JobID:         d.JobID,
+		UserID:        d.UserID,
+		Code:          d.Code,
+		Status:        d.Status,
+		Attempts:      d.Attempts,
+		LastError:     d.LastError,
+		NextAttemptAt: d.NextAttemptAt,
+		CreatedAt:     d.CreatedAt,
+		DoneAt:        d.DoneAt,
+		DeadAt:        d.DeadAt,
+	}
+}
+
+// ToCanonical converts storage objects to canonical models.
+func (d Deletions) ToCanonical() []model.Deletion {
+	objs := make([]model.Deletion, 0, len(d))
+	for _, obj := range d {
+		objs = append(objs, obj.ToCanonical())
+	}
+
+	return objs
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/vstdy/go-shortener/model"
@@ -9,9 +11,18 @@ import (
 type (
 	URL struct {
 		ID            int
+		Code          string
 		CorrelationID string
 		UserID        uuid.UUID
+		DedupUserID   uuid.UUID
 		URL           string
+		InputURL      string
+		ExpiresAt     time.Time
+		MaxClicks     int
+		Clicks        int
+		RedirectCode  int
+		CreatedAt     time.Time
+		DeletedAt     time.Time
 	}
 
 	URLS []URL
@@ -23,9 +34,18 @@ func NewURLsFromCanonical(objs []model.URL) URLS {
 	for _, url := range objs {
 		urls = append(urls, URL{
 			ID:            url.ID,
+			Code:          url.Code,
 			CorrelationID: url.CorrelationID,
 			UserID:        url.UserID,
+			DedupUserID:   url.DedupUserID,
 			URL:           url.URL,
+			InputURL:      url.InputURL,
+			ExpiresAt:     url.ExpiresAt,
+			MaxClicks:     url.MaxClicks,
+			Clicks:        url.Clicks,
+			RedirectCode:  url.RedirectCode,
+			CreatedAt:     url.CreatedAt,
+			DeletedAt:     url.DeletedAt,
 		})
 	}
 
@@ -36,9 +56,18 @@ func NewURLsFromCanonical(objs []model.URL) URLS {
 func (u URL) ToCanonical() model.URL {
 	obj := model.URL{
 		ID:            u.ID,
+		Code:          u.Code,
 		CorrelationID: u.CorrelationID,
 		UserID:        u.UserID,
+		DedupUserID:   u.DedupUserID,
 		URL:           u.URL,
+		InputURL:      u.InputURL,
+		ExpiresAt:     u.ExpiresAt,
+		MaxClicks:     u.MaxClicks,
+		Clicks:        u.Clicks,
+		RedirectCode:  u.RedirectCode,
+		CreatedAt:     u.CreatedAt,
+		DeletedAt:     u.DeletedAt,
 	}
 
 	return obj
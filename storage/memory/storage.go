@@ -3,6 +3,8 @@ package memory
 import (
 	"sync"
 
+	"github.com/google/uuid"
+
 	"github.com/vstdy/go-shortener/pkg"
 	inter "github.com/vstdy/go-shortener/storage"
 	"github.com/vstdy/go-shortener/storage/memory/schema"
@@ -10,19 +12,37 @@ import (
 
 var _ inter.Storage = (*Storage)(nil)
 
+// urlKey identifies url object within its dedup scope.
+type urlKey struct {
+	dedupUserID uuid.UUID
+	url         string
+}
+
 // Storage keeps memory storage dependencies.
 type Storage struct {
 	sync.RWMutex
 
-	id   int
-	urls map[int]schema.URL
+	id       int
+	urls     map[int]schema.URL
+	codes    map[string]int
+	urlIndex map[urlKey]int
+	clickID  int
+	clicks   schema.Clicks
+
+	deletionID int
+	deletions  map[int]schema.Deletion
 }
 
 // NewStorage creates a new memory Storage.
 func NewStorage() (*Storage, error) {
 	var st Storage
 	st.urls = make(map[int]schema.URL)
+	st.codes = make(map[string]int)
+	st.urlIndex = make(map[urlKey]int)
 	st.id = 1
+	st.clickID = 1
+	st.deletions = make(map[int]schema.Deletion)
+	st.deletionID = 1
 
 	return &st, nil
 }
@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	storage *Storage
+
+	ctx context.Context
+}
+
+func (s *TestSuite) SetupTest() {
+	st, err := NewStorage()
+	s.Require().NoError(err)
+
+	s.storage = st
+	s.ctx = context.TODO()
+}
+
+func TestSuite_MemoryStorage(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -3,10 +3,13 @@ package memory
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
 	"github.com/vstdy/go-shortener/storage/memory/schema"
 )
 
@@ -15,60 +18,372 @@ func (st *Storage) HasURL(ctx context.Context, urlID int) (bool, error) {
 	st.RLock()
 	defer st.RUnlock()
 
-	_, ok := st.urls[urlID]
+	url, ok := st.urls[urlID]
 
-	return ok, nil
+	return ok && url.DeletedAt.IsZero(), nil
 }
 
 // AddURLs adds given url objects to storage
-func (st *Storage) AddURLs(ctx context.Context, objs []model.URL) ([]model.URL, error) {
+// Objects with already stored urls are not added, stored objects are returned instead
+// along with ErrAlreadyExists. Objects repeating a url within the batch share the added object.
+// With user dedup scope urls are deduplicated among objects of the same user only.
+func (st *Storage) AddURLs(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
 	st.Lock()
 	defer st.Unlock()
 
 	dbObjs := schema.NewURLsFromCanonical(objs)
+	if scope == model.DedupScopeUser {
+		for idx := range dbObjs {
+			dbObjs[idx].DedupUserID = dbObjs[idx].UserID
+		}
+	}
+
+	now := time.Now()
+	exists := false
+	isNew := make([]bool, len(dbObjs))
+	batchURLs := make(map[urlKey]bool, len(dbObjs))
+	var newObjs schema.URLS
+	for idx, obj := range dbObjs {
+		key := newURLKey(obj)
+		if _, ok := st.urlIndex[key]; ok {
+			exists = true
+			continue
+		}
+		if batchURLs[key] {
+			continue
+		}
+
+		batchURLs[key] = true
+		isNew[idx] = true
+		newObjs = append(newObjs, obj)
+	}
+
+	if err := st.checkCodes(newObjs); err != nil {
+		return nil, fmt.Errorf("memory: AddURLs: %w", err)
+	}
 
 	for idx := range dbObjs {
+		if !isNew[idx] {
+			continue
+		}
+
 		dbObjs[idx].ID = st.id
+		if dbObjs[idx].CreatedAt.IsZero() {
+			dbObjs[idx].CreatedAt = now
+		}
 
-		st.urls[dbObjs[idx].ID] = dbObjs[idx]
+		st.putURL(dbObjs[idx])
 		st.id++
 	}
 
+	for idx := range dbObjs {
+		if isNew[idx] {
+			continue
+		}
+
+		storedObj := st.urls[st.urlIndex[newURLKey(dbObjs[idx])]]
+		storedObj.CorrelationID = dbObjs[idx].CorrelationID
+		dbObjs[idx] = storedObj
+	}
+
 	addedObjs := dbObjs.ToCanonical()
 
+	if exists {
+		return addedObjs, fmt.Errorf("memory: AddURLs: %w", pkg.ErrAlreadyExists)
+	}
+
 	return addedObjs, nil
 }
 
-// GetURL gets url object with given id
-func (st *Storage) GetURL(ctx context.Context, urlID int) (model.URL, error) {
+// GetURL gets url object with given short code
+func (st *Storage) GetURL(ctx context.Context, code string) (model.URL, error) {
 	st.RLock()
 	defer st.RUnlock()
 
-	url, ok := st.urls[urlID]
+	url, ok := st.liveURL(code)
 	if !ok {
-		return model.URL{}, fmt.Errorf("url does not exist")
+		return model.URL{}, nil
+	}
+
+	return url.ToCanonical(), nil
+}
+
+// RedeemURL counts a click of the url object with given short code
+// Objects out of clicks or expired by the time of redeem are not counted.
+func (st *Storage) RedeemURL(ctx context.Context, code string) (model.URL, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	url, ok := st.liveURL(code)
+	if !ok || url.MaxClicks > 0 && url.Clicks >= url.MaxClicks ||
+		!url.ExpiresAt.IsZero() && !url.ExpiresAt.After(time.Now()) {
+		return model.URL{}, nil
+	}
+
+	url.Clicks++
+	st.putURL(url)
+
+	return url.ToCanonical(), nil
+}
+
+// GetUsersURLs gets current user url objects matching given query
+func (st *Storage) GetUsersURLs(ctx context.Context, userID uuid.UUID, query model.URLsQuery) ([]model.URL, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	var urls []model.URL
+	for _, v := range st.urls {
+		if v.UserID != userID || !v.DeletedAt.IsZero() {
+			continue
+		}
+
+		url := v.ToCanonical()
+		if query.Filter.Match(url) && query.IsAfter(url) {
+			urls = append(urls, url)
+		}
+	}
+
+	sort.Slice(urls, func(i, j int) bool {
+		return query.Less(urls[i], urls[j])
+	})
+	if len(urls) > query.Limit {
+		urls = urls[:query.Limit]
+	}
+
+	return urls, nil
+}
+
+// UpdateURL updates current user url object with given short code
+func (st *Storage) UpdateURL(ctx context.Context, obj model.URL) (model.URL, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	url, ok := st.liveURL(obj.Code)
+	if !ok || url.UserID != obj.UserID {
+		return model.URL{}, nil
 	}
 
+	if obj.URL != "" {
+		if obj.URL != url.URL {
+			if _, ok := st.urlIndex[urlKey{dedupUserID: url.DedupUserID, url: obj.URL}]; ok {
+				return model.URL{}, fmt.Errorf("memory: UpdateURL: %w", pkg.ErrAlreadyExists)
+			}
+		}
+
+		url.URL = obj.URL
+		url.InputURL = obj.InputURL
+	}
+	if obj.RedirectCode != 0 {
+		url.RedirectCode = obj.RedirectCode
+	}
+
+	st.putURL(url)
+
 	return url.ToCanonical(), nil
 }
 
-// GetUsersURLs gets current user url objects
-func (st *Storage) GetUsersURLs(ctx context.Context, userID uuid.UUID) ([]model.URL, error) {
+// GetUsersDeletedURLs gets current user url objects deleted since given time
+func (st *Storage) GetUsersDeletedURLs(ctx context.Context, userID uuid.UUID, since time.Time) ([]model.URL, error) {
 	st.RLock()
 	defer st.RUnlock()
 
+	now := time.Now()
+
 	var urls schema.URLS
 	for _, v := range st.urls {
-		if v.UserID == userID {
+		if v.UserID == userID && isRestorable(v, since, now) {
 			urls = append(urls, v)
 		}
 	}
 
+	sort.Slice(urls, func(i, j int) bool {
+		return urls[i].DeletedAt.After(urls[j].DeletedAt)
+	})
+
 	return urls.ToCanonical(), nil
 }
 
-// RemoveUsersURLs removes current user url objects with given ids
-func (st *Storage) RemoveUsersURLs(ctx context.Context, objs []model.URL) error {
+// RestoreURL restores current user url object with given short code deleted since given time
+func (st *Storage) RestoreURL(ctx context.Context, obj model.URL, since time.Time) (model.URL, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	url, ok := st.urls[st.codes[obj.Code]]
+	if !ok || url.UserID != obj.UserID || !isRestorable(url, since, time.Now()) {
+		return model.URL{}, nil
+	}
+
+	if _, ok := st.urlIndex[newURLKey(url)]; ok {
+		return model.URL{}, fmt.Errorf("memory: RestoreURL: %w", pkg.ErrAlreadyExists)
+	}
+
+	url.DeletedAt = time.Time{}
+	st.putURL(url)
+
+	return url.ToCanonical(), nil
+}
+
+// RemoveUsersURLs removes current user url objects with given short codes
+// Removed objects are kept with deletion time set, their short codes stay taken.
+// Objects already removed by the user are reported deleted.
+func (st *Storage) RemoveUsersURLs(ctx context.Context, objs []model.URL) ([]model.DeletionStatus, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	now := time.Now()
+	statuses := make([]model.DeletionStatus, 0, len(objs))
+	for _, obj := range objs {
+		url, ok := st.urls[st.codes[obj.Code]]
+		status := deletionStatus(url, ok, obj.UserID)
+		statuses = append(statuses, status)
+		if status != model.DeletionDeleted || !url.DeletedAt.IsZero() {
+			continue
+		}
+
+		url.DeletedAt = now
+		st.putURL(url)
+	}
+
+	return statuses, nil
+}
+
+// RemoveExpiredURLs removes url objects expired by given time
+// Expired objects are kept with deletion time set, their short codes stay taken.
+func (st *Storage) RemoveExpiredURLs(ctx context.Context, before time.Time) (int, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	cnt := 0
+	for _, url := range st.urls {
+		if url.DeletedAt.IsZero() && !url.ExpiresAt.IsZero() && !url.ExpiresAt.After(before) {
+			url.DeletedAt = before
+			st.putURL(url)
+			cnt++
+		}
+	}
+
+	return cnt, nil
+}
+
+// ListURLs gets at most limit url objects with id greater than afterID ordered by id
+// Removed objects are listed as well.
+func (st *Storage) ListURLs(ctx context.Context, afterID, limit int) ([]model.URL, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	var urls schema.URLS
+	for _, v := range st.urls {
+		if v.ID > afterID {
+			urls = append(urls, v)
+		}
+	}
+
+	sort.Slice(urls, func(i, j int) bool {
+		return urls[i].ID < urls[j].ID
+	})
+	if len(urls) > limit {
+		urls = urls[:limit]
+	}
+
+	return urls.ToCanonical(), nil
+}
+
+// ImportURLs adds given url objects to storage keeping their ids
+// Objects with already stored ids are skipped, so an interrupted import can be repeated.
+func (st *Storage) ImportURLs(ctx context.Context, objs []model.URL) error {
+	st.Lock()
+	defer st.Unlock()
+
+	var newObjs schema.URLS
+	batchURLs := make(map[urlKey]bool, len(objs))
+	for _, obj := range schema.NewURLsFromCanonical(objs) {
+		if _, ok := st.urls[obj.ID]; ok {
+			continue
+		}
+		if obj.DeletedAt.IsZero() {
+			key := newURLKey(obj)
+			if _, ok := st.urlIndex[key]; ok || batchURLs[key] {
+				return fmt.Errorf("memory: ImportURLs: %w", pkg.ErrAlreadyExists)
+			}
+			batchURLs[key] = true
+		}
+
+		newObjs = append(newObjs, obj)
+	}
+
+	if err := st.checkCodes(newObjs); err != nil {
+		return fmt.Errorf("memory: ImportURLs: %w", err)
+	}
+
+	for _, obj := range newObjs {
+		st.putURL(obj)
+		if obj.ID >= st.id {
+			st.id = obj.ID + 1
+		}
+	}
+
+	return nil
+}
+
+// checkCodes checks short codes of given objects are not taken.
+func (st *Storage) checkCodes(objs schema.URLS) error {
+	batchCodes := make(map[string]bool, len(objs))
+	for _, obj := range objs {
+		if _, ok := st.codes[obj.Code]; ok || batchCodes[obj.Code] {
+			return pkg.ErrCodeTaken
+		}
+		batchCodes[obj.Code] = true
+	}
 
 	return nil
 }
+
+// putURL stores url object keeping short codes and urls indexes in sync.
+// Only not removed objects are indexed by url within their dedup scope.
+func (st *Storage) putURL(url schema.URL) {
+	if prevURL, ok := st.urls[url.ID]; ok && st.urlIndex[newURLKey(prevURL)] == url.ID {
+		delete(st.urlIndex, newURLKey(prevURL))
+	}
+
+	st.urls[url.ID] = url
+	st.codes[url.Code] = url.ID
+	if url.DeletedAt.IsZero() {
+		st.urlIndex[newURLKey(url)] = url.ID
+	}
+}
+
+// newURLKey creates urls index key of the url object.
+func newURLKey(url schema.URL) urlKey {
+	return urlKey{dedupUserID: url.DedupUserID, url: url.URL}
+}
+
+// liveURL gets not removed url object with given short code.
+func (st *Storage) liveURL(code string) (schema.URL, bool) {
+	url, ok := st.urls[st.codes[code]]
+	if !ok || !url.DeletedAt.IsZero() {
+		return schema.URL{}, false
+	}
+
+	return url, true
+}
+
+// deletionStatus returns the outcome of the url object deletion by given user.
+// Objects removed by other users are not found.
+func deletionStatus(url schema.URL, ok bool, userID uuid.UUID) model.DeletionStatus {
+	switch {
+	case !ok:
+		return model.DeletionNotFound
+	case url.UserID == userID:
+		return model.DeletionDeleted
+	case url.DeletedAt.IsZero():
+		return model.DeletionNotOwned
+	default:
+		return model.DeletionNotFound
+	}
+}
+
+// isRestorable checks the url object is removed since given time and not expired.
+func isRestorable(url schema.URL, since, now time.Time) bool {
+	return !url.DeletedAt.IsZero() && !url.DeletedAt.Before(since) &&
+		(url.ExpiresAt.IsZero() || url.ExpiresAt.After(now))
+}
@@ -0,0 +1,435 @@
+package memory
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+)
+
+func (s *TestSuite) TestURLs_AddURLs() {
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-1.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	s.Run("Add already shortened url", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "e4F5g6H",
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Require().Len(res, 1)
+		s.Assert().Equal(urls[0].ID, res[0].ID)
+		s.Assert().Equal(urls[0].Code, res[0].Code)
+	})
+
+	s.Run("Add mixed batch", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:          "i7J8k9L",
+				CorrelationID: "1",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-2.com/",
+			},
+			{
+				Code:          "a1B2c3D",
+				CorrelationID: "2",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Require().Len(res, 2)
+		s.Assert().Equal("1", res[0].CorrelationID)
+		s.Assert().Equal("i7J8k9L", res[0].Code)
+		s.Assert().Equal("2", res[1].CorrelationID)
+		s.Assert().Equal(urls[0].ID, res[1].ID)
+
+		added, err := s.storage.GetURL(s.ctx, "i7J8k9L")
+		s.Require().NoError(err)
+		s.Assert().Equal("https://lengthy-url-2.com/", added.URL)
+	})
+
+	s.Run("Add batch repeating url", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:          "q6R7s8T",
+				CorrelationID: "1",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-3.com/",
+			},
+			{
+				Code:          "u9V0w1X",
+				CorrelationID: "2",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-3.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		s.Assert().Equal(res[0].ID, res[1].ID)
+		s.Assert().Equal("q6R7s8T", res[1].Code)
+		s.Assert().Equal("2", res[1].CorrelationID)
+	})
+
+	s.Run("Update url to already shortened one", func() {
+		_, err := s.storage.UpdateURL(s.ctx, model.URL{
+			Code:   urls[0].Code,
+			UserID: urls[0].UserID,
+			URL:    "https://lengthy-url-2.com/",
+		})
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+	})
+
+	s.Run("Add url of removed one", func() {
+		_, err := s.storage.RemoveUsersURLs(s.ctx, urls)
+		s.Require().NoError(err)
+
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "y2Z3a4B",
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+		s.Assert().NotEqual(urls[0].ID, res[0].ID)
+
+		_, err = s.storage.RestoreURL(s.ctx, urls[0], time.Now().Add(-time.Hour))
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+	})
+}
+
+func (s *TestSuite) TestURLs_RedeemURL() {
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{Code: "a1B2c3D", UserID: uuid.New(), URL: "https://lengthy-url-1.com/", MaxClicks: 1},
+		{
+			Code:      "e4F5g6H",
+			UserID:    uuid.New(),
+			URL:       "https://lengthy-url-2.com/",
+			MaxClicks: 5,
+			ExpiresAt: time.Now().Add(-time.Minute),
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	res, err := s.storage.RedeemURL(s.ctx, urls[0].Code)
+	s.Require().NoError(err)
+	s.Assert().Equal(1, res.Clicks)
+
+	for _, url := range urls {
+		res, err = s.storage.RedeemURL(s.ctx, url.Code)
+		s.Require().NoError(err)
+		s.Assert().Zero(res, url.Code)
+	}
+}
+
+func (s *TestSuite) TestURLs_AddURLsUserScope() {
+	userA, userB := uuid.New(), uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: userA,
+			URL:    "https://lengthy-url-1.com/",
+		},
+	}, model.DedupScopeUser)
+	s.Require().NoError(err)
+
+	s.Run("Add url shortened by another user", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "e4F5g6H",
+				UserID: userB,
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeUser)
+		s.Require().NoError(err)
+		s.Assert().Equal("e4F5g6H", res[0].Code)
+		s.Assert().Equal(userB, res[0].UserID)
+
+		usersURLs, err := s.storage.GetUsersURLs(s.ctx, userB, model.URLsQuery{Limit: 100})
+		s.Require().NoError(err)
+		s.Require().Len(usersURLs, 1)
+		s.Assert().Equal("e4F5g6H", usersURLs[0].Code)
+	})
+
+	s.Run("Add url shortened by the same user", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "i7J8k9L",
+				UserID: userA,
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeUser)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Assert().Equal(urls[0].ID, res[0].ID)
+	})
+
+	s.Run("Add url shortened by a user with global scope", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "m3N4o5P",
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-1.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+		s.Assert().Equal("m3N4o5P", res[0].Code)
+	})
+}
+
+func (s *TestSuite) TestURLs_RemoveUserURLs() {
+	userID := uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: userID,
+			URL:    "https://lengthy-url-1.com/",
+		},
+		{
+			Code:   "e4F5g6H",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-2.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	s.Run("Remove urls by creator", func() {
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, []model.URL{{Code: urls[0].Code, UserID: userID}})
+		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{model.DeletionDeleted}, statuses)
+
+		res, err := s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+
+		exists, err := s.storage.HasURL(s.ctx, urls[0].ID)
+		s.Require().NoError(err)
+		s.Assert().False(exists)
+
+		userURLs, err := s.storage.GetUsersURLs(s.ctx, userID, model.URLsQuery{Limit: 100})
+		s.Require().NoError(err)
+		s.Assert().Empty(userURLs)
+	})
+
+	s.Run("Remove urls by non-creator", func() {
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, []model.URL{{Code: urls[1].Code, UserID: userID}})
+		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{model.DeletionNotOwned}, statuses)
+
+		res, err := s.storage.GetURL(s.ctx, urls[1].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(urls[1], res)
+	})
+
+	s.Run("Remove removed and missing urls", func() {
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, []model.URL{
+			{Code: urls[0].Code, UserID: userID},
+			{Code: urls[0].Code, UserID: uuid.New()},
+			{Code: "m1N2o3P", UserID: userID},
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{
+			model.DeletionDeleted, model.DeletionNotFound, model.DeletionNotFound,
+		}, statuses)
+	})
+
+	s.Run("Removed url code stays taken", func() {
+		_, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   urls[0].Code,
+				UserID: userID,
+				URL:    "https://lengthy-url-3.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().Error(err)
+	})
+}
+
+func (s *TestSuite) TestURLs_RestoreURL() {
+	userID := uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: userID,
+			URL:    "https://lengthy-url-1.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	since := time.Now().Add(-time.Hour)
+	_, err = s.storage.RemoveUsersURLs(s.ctx, urls)
+	s.Require().NoError(err)
+
+	s.Run("Get deleted urls", func() {
+		res, err := s.storage.GetUsersDeletedURLs(s.ctx, userID, since)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(urls[0].Code, res[0].Code)
+		s.Assert().False(res[0].DeletedAt.IsZero())
+	})
+
+	s.Run("Restore url by non-creator", func() {
+		res, err := s.storage.RestoreURL(s.ctx, model.URL{Code: urls[0].Code, UserID: uuid.New()}, since)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Restore url deleted before grace period", func() {
+		res, err := s.storage.RestoreURL(s.ctx, urls[0], time.Now().Add(time.Hour))
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Restore url by creator", func() {
+		res, err := s.storage.RestoreURL(s.ctx, urls[0], since)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(urls[0], res)
+
+		res, err = s.storage.GetURL(s.ctx, urls[0].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(urls[0], res)
+	})
+}
+
+func (s *TestSuite) TestURLs_GetUsersURLs() {
+	userID := uuid.New()
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:   "a1B2c3D",
+			UserID: userID,
+			URL:    "https://lengthy-url-1.com/",
+		},
+		{
+			Code:   "e4F5g6H",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-2.com/",
+		},
+		{
+			Code:   "i7J8k9L",
+			UserID: userID,
+			URL:    "https://lengthy-url-3.com/",
+		},
+		{
+			Code:   "m3N4o5P",
+			UserID: userID,
+			URL:    "https://lengthy-url-4.com/",
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	s.Run("Get first page", func() {
+		res, err := s.storage.GetUsersURLs(s.ctx, userID, model.URLsQuery{Limit: 2})
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		s.Assert().Equal(urls[0].ID, res[0].ID)
+		s.Assert().Equal(urls[2].ID, res[1].ID)
+	})
+
+	s.Run("Get next page", func() {
+		res, err := s.storage.GetUsersURLs(s.ctx, userID, model.URLsQuery{AfterID: urls[2].ID, Limit: 2})
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(urls[3].ID, res[0].ID)
+	})
+}
+
+func (s *TestSuite) TestURLs_GetUsersURLsFiltered() {
+	userID := uuid.New()
+	createdAt := time.Now().Add(-time.Hour)
+	urls, err := s.storage.AddURLs(s.ctx, []model.URL{
+		{
+			Code:      "a1B2c3D",
+			UserID:    userID,
+			URL:       "https://Shop.Example.com/spring-sale",
+			CreatedAt: createdAt.Add(2 * time.Minute),
+		},
+		{
+			Code:      "e4F5g6H",
+			UserID:    userID,
+			URL:       "https://example.com/summer-sale",
+			CreatedAt: createdAt,
+		},
+		{
+			Code:      "i7J8k9L",
+			UserID:    userID,
+			URL:       "https://notexample.com/spring-sale",
+			CreatedAt: createdAt.Add(time.Minute),
+		},
+	}, model.DedupScopeGlobal)
+	s.Require().NoError(err)
+
+	ids := func(objs []model.URL) []int {
+		res := make([]int, 0, len(objs))
+		for _, obj := range objs {
+			res = append(res, obj.ID)
+		}
+		return res
+	}
+
+	s.Run("Search by url substring", func() {
+		res, err := s.storage.GetUsersURLs(s.ctx, userID, model.URLsQuery{
+			Filter: model.URLsFilter{Search: "SPRING"},
+			Limit:  100,
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal([]int{urls[0].ID, urls[2].ID}, ids(res))
+	})
+
+	s.Run("Filter by domain", func() {
+		res, err := s.storage.GetUsersURLs(s.ctx, userID, model.URLsQuery{
+			Filter: model.URLsFilter{Domain: "example.com"},
+			Limit:  100,
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal([]int{urls[0].ID, urls[1].ID}, ids(res))
+	})
+
+	s.Run("Filter by creation time", func() {
+		res, err := s.storage.GetUsersURLs(s.ctx, userID, model.URLsQuery{
+			Filter: model.URLsFilter{CreatedFrom: createdAt.Add(time.Minute), CreatedTo: createdAt.Add(2 * time.Minute)},
+			Limit:  100,
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal([]int{urls[2].ID}, ids(res))
+	})
+
+	s.Run("Sort by creation time", func() {
+		query := model.URLsQuery{Sort: model.ListSortCreatedAt, Limit: 2}
+		res, err := s.storage.GetUsersURLs(s.ctx, userID, query)
+		s.Require().NoError(err)
+		s.Assert().Equal([]int{urls[1].ID, urls[2].ID}, ids(res))
+
+		query.AfterID, query.AfterCreatedAt = res[1].ID, res[1].CreatedAt
+		res, err = s.storage.GetUsersURLs(s.ctx, userID, query)
+		s.Require().NoError(err)
+		s.Assert().Equal([]int{urls[0].ID}, ids(res))
+	})
+
+	s.Run("Sort by id descending", func() {
+		res, err := s.storage.GetUsersURLs(s.ctx, userID, model.URLsQuery{
+			Sort:    model.ListSortID,
+			Desc:    true,
+			AfterID: urls[2].ID,
+			Limit:   100,
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal([]int{urls[1].ID, urls[0].ID}, ids(res))
+	})
+}
@@ -7,6 +7,7 @@ package storagemock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,19 +37,63 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// AddClicks mocks base method.
+func (m *MockStorage) AddClicks(ctx context.Context, objs []model.Click) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddClicks", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddClicks indicates an expected call of AddClicks.
+func (mr *MockStorageMockRecorder) AddClicks(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClicks", reflect.TypeOf((*MockStorage)(nil).AddClicks), ctx, objs)
+}
+
+// AddDeletions mocks base method.
+func (m *MockStorage) AddDeletions(ctx context.Context, objs []model.Deletion) ([]model.Deletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeletions", ctx, objs)
+	ret0, _ := ret[0].([]model.Deletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDeletions indicates an expected call of AddDeletions.
+func (mr *MockStorageMockRecorder) AddDeletions(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeletions", reflect.TypeOf((*MockStorage)(nil).AddDeletions), ctx, objs)
+}
+
 // AddURLs mocks base method.
-func (m *MockStorage) AddURLs(ctx context.Context, objs []model.URL) ([]model.URL, error) {
+func (m *MockStorage) AddURLs(ctx context.Context, objs []model.URL, scope model.DedupScope) ([]model.URL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddURLs", ctx, objs)
+	ret := m.ctrl.Call(m, "AddURLs", ctx, objs, scope)
 	ret0, _ := ret[0].([]model.URL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddURLs indicates an expected call of AddURLs.
-func (mr *MockStorageMockRecorder) AddURLs(ctx, objs interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) AddURLs(ctx, objs, scope interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddURLs", reflect.TypeOf((*MockStorage)(nil).AddURLs), ctx, objs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddURLs", reflect.TypeOf((*MockStorage)(nil).AddURLs), ctx, objs, scope)
+}
+
+// ClaimDueDeletions mocks base method.
+func (m *MockStorage) ClaimDueDeletions(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]model.Deletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueDeletions", ctx, now, limit, lease)
+	ret0, _ := ret[0].([]model.Deletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueDeletions indicates an expected call of ClaimDueDeletions.
+func (mr *MockStorageMockRecorder) ClaimDueDeletions(ctx, now, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueDeletions", reflect.TypeOf((*MockStorage)(nil).ClaimDueDeletions), ctx, now, limit, lease)
 }
 
 // Close mocks base method.
@@ -65,34 +110,109 @@ func (mr *MockStorageMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorage)(nil).Close))
 }
 
+// GetClickStats mocks base method.
+func (m *MockStorage) GetClickStats(ctx context.Context, urlID int, params model.StatsParams, topLimit int) (model.URLStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClickStats", ctx, urlID, params, topLimit)
+	ret0, _ := ret[0].(model.URLStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClickStats indicates an expected call of GetClickStats.
+func (mr *MockStorageMockRecorder) GetClickStats(ctx, urlID, params, topLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClickStats", reflect.TypeOf((*MockStorage)(nil).GetClickStats), ctx, urlID, params, topLimit)
+}
+
+// GetDeadDeletions mocks base method.
+func (m *MockStorage) GetDeadDeletions(ctx context.Context, afterID, limit int) ([]model.Deletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadDeletions", ctx, afterID, limit)
+	ret0, _ := ret[0].([]model.Deletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadDeletions indicates an expected call of GetDeadDeletions.
+func (mr *MockStorageMockRecorder) GetDeadDeletions(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadDeletions", reflect.TypeOf((*MockStorage)(nil).GetDeadDeletions), ctx, afterID, limit)
+}
+
+// GetJobDeletions mocks base method.
+func (m *MockStorage) GetJobDeletions(ctx context.Context, jobID uuid.UUID) ([]model.Deletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobDeletions", ctx, jobID)
+	ret0, _ := ret[0].([]model.Deletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobDeletions indicates an expected call of GetJobDeletions.
+func (mr *MockStorageMockRecorder) GetJobDeletions(ctx, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobDeletions", reflect.TypeOf((*MockStorage)(nil).GetJobDeletions), ctx, jobID)
+}
+
 // GetURL mocks base method.
-func (m *MockStorage) GetURL(ctx context.Context, urlID int) (model.URL, error) {
+func (m *MockStorage) GetURL(ctx context.Context, code string) (model.URL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetURL", ctx, urlID)
+	ret := m.ctrl.Call(m, "GetURL", ctx, code)
 	ret0, _ := ret[0].(model.URL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetURL indicates an expected call of GetURL.
-func (mr *MockStorageMockRecorder) GetURL(ctx, urlID interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetURL(ctx, code interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetURL", reflect.TypeOf((*MockStorage)(nil).GetURL), ctx, urlID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetURL", reflect.TypeOf((*MockStorage)(nil).GetURL), ctx, code)
+}
+
+// GetUsersDeletedURLs mocks base method.
+func (m *MockStorage) GetUsersDeletedURLs(ctx context.Context, userID uuid.UUID, since time.Time) ([]model.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersDeletedURLs", ctx, userID, since)
+	ret0, _ := ret[0].([]model.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersDeletedURLs indicates an expected call of GetUsersDeletedURLs.
+func (mr *MockStorageMockRecorder) GetUsersDeletedURLs(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersDeletedURLs", reflect.TypeOf((*MockStorage)(nil).GetUsersDeletedURLs), ctx, userID, since)
 }
 
 // GetUsersURLs mocks base method.
-func (m *MockStorage) GetUsersURLs(ctx context.Context, userID uuid.UUID) ([]model.URL, error) {
+func (m *MockStorage) GetUsersURLs(ctx context.Context, userID uuid.UUID, query model.URLsQuery) ([]model.URL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersURLs", ctx, userID)
+	ret := m.ctrl.Call(m, "GetUsersURLs", ctx, userID, query)
 	ret0, _ := ret[0].([]model.URL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUsersURLs indicates an expected call of GetUsersURLs.
-func (mr *MockStorageMockRecorder) GetUsersURLs(ctx, userID interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetUsersURLs(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersURLs", reflect.TypeOf((*MockStorage)(nil).GetUsersURLs), ctx, userID, query)
+}
+
+// HasPendingDeletions mocks base method.
+func (m *MockStorage) HasPendingDeletions(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPendingDeletions", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPendingDeletions indicates an expected call of HasPendingDeletions.
+func (mr *MockStorageMockRecorder) HasPendingDeletions(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersURLs", reflect.TypeOf((*MockStorage)(nil).GetUsersURLs), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPendingDeletions", reflect.TypeOf((*MockStorage)(nil).HasPendingDeletions), ctx)
 }
 
 // HasURL mocks base method.
@@ -110,6 +230,64 @@ func (mr *MockStorageMockRecorder) HasURL(ctx, urlID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasURL", reflect.TypeOf((*MockStorage)(nil).HasURL), ctx, urlID)
 }
 
+// ImportClicks mocks base method.
+func (m *MockStorage) ImportClicks(ctx context.Context, objs []model.Click) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportClicks", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportClicks indicates an expected call of ImportClicks.
+func (mr *MockStorageMockRecorder) ImportClicks(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportClicks", reflect.TypeOf((*MockStorage)(nil).ImportClicks), ctx, objs)
+}
+
+// ImportURLs mocks base method.
+func (m *MockStorage) ImportURLs(ctx context.Context, objs []model.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportURLs", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportURLs indicates an expected call of ImportURLs.
+func (mr *MockStorageMockRecorder) ImportURLs(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportURLs", reflect.TypeOf((*MockStorage)(nil).ImportURLs), ctx, objs)
+}
+
+// ListClicks mocks base method.
+func (m *MockStorage) ListClicks(ctx context.Context, afterID, limit int) ([]model.Click, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClicks", ctx, afterID, limit)
+	ret0, _ := ret[0].([]model.Click)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClicks indicates an expected call of ListClicks.
+func (mr *MockStorageMockRecorder) ListClicks(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClicks", reflect.TypeOf((*MockStorage)(nil).ListClicks), ctx, afterID, limit)
+}
+
+// ListURLs mocks base method.
+func (m *MockStorage) ListURLs(ctx context.Context, afterID, limit int) ([]model.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListURLs", ctx, afterID, limit)
+	ret0, _ := ret[0].([]model.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListURLs indicates an expected call of ListURLs.
+func (mr *MockStorageMockRecorder) ListURLs(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListURLs", reflect.TypeOf((*MockStorage)(nil).ListURLs), ctx, afterID, limit)
+}
+
 // Ping mocks base method.
 func (m *MockStorage) Ping() error {
 	m.ctrl.T.Helper()
@@ -124,12 +302,58 @@ func (mr *MockStorageMockRecorder) Ping() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockStorage)(nil).Ping))
 }
 
+// RedeemURL mocks base method.
+func (m *MockStorage) RedeemURL(ctx context.Context, code string) (model.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemURL", ctx, code)
+	ret0, _ := ret[0].(model.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemURL indicates an expected call of RedeemURL.
+func (mr *MockStorageMockRecorder) RedeemURL(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemURL", reflect.TypeOf((*MockStorage)(nil).RedeemURL), ctx, code)
+}
+
+// RemoveDoneDeletions mocks base method.
+func (m *MockStorage) RemoveDoneDeletions(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDoneDeletions", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDoneDeletions indicates an expected call of RemoveDoneDeletions.
+func (mr *MockStorageMockRecorder) RemoveDoneDeletions(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDoneDeletions", reflect.TypeOf((*MockStorage)(nil).RemoveDoneDeletions), ctx, before)
+}
+
+// RemoveExpiredURLs mocks base method.
+func (m *MockStorage) RemoveExpiredURLs(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExpiredURLs", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveExpiredURLs indicates an expected call of RemoveExpiredURLs.
+func (mr *MockStorageMockRecorder) RemoveExpiredURLs(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExpiredURLs", reflect.TypeOf((*MockStorage)(nil).RemoveExpiredURLs), ctx, before)
+}
+
 // RemoveUsersURLs mocks base method.
-func (m *MockStorage) RemoveUsersURLs(ctx context.Context, objs []model.URL) error {
+func (m *MockStorage) RemoveUsersURLs(ctx context.Context, objs []model.URL) ([]model.DeletionStatus, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveUsersURLs", ctx, objs)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]model.DeletionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RemoveUsersURLs indicates an expected call of RemoveUsersURLs.
@@ -137,3 +361,47 @@ func (mr *MockStorageMockRecorder) RemoveUsersURLs(ctx, objs interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUsersURLs", reflect.TypeOf((*MockStorage)(nil).RemoveUsersURLs), ctx, objs)
 }
+
+// RestoreURL mocks base method.
+func (m *MockStorage) RestoreURL(ctx context.Context, obj model.URL, since time.Time) (model.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreURL", ctx, obj, since)
+	ret0, _ := ret[0].(model.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreURL indicates an expected call of RestoreURL.
+func (mr *MockStorageMockRecorder) RestoreURL(ctx, obj, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreURL", reflect.TypeOf((*MockStorage)(nil).RestoreURL), ctx, obj, since)
+}
+
+// UpdateDeletions mocks base method.
+func (m *MockStorage) UpdateDeletions(ctx context.Context, objs []model.Deletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeletions", ctx, objs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeletions indicates an expected call of UpdateDeletions.
+func (mr *MockStorageMockRecorder) UpdateDeletions(ctx, objs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeletions", reflect.TypeOf((*MockStorage)(nil).UpdateDeletions), ctx, objs)
+}
+
+// UpdateURL mocks base method.
+func (m *MockStorage) UpdateURL(ctx context.Context, obj model.URL) (model.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateURL", ctx, obj)
+	ret0, _ := ret[0].(model.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateURL indicates an expected call of UpdateURL.
+func (mr *MockStorageMockRecorder) UpdateURL(ctx, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateURL", reflect.TypeOf((*MockStorage)(nil).UpdateURL), ctx, obj)
+}
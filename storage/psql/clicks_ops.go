@@ -0,0 +1,170 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg/tracing"
+	"github.com/vstdy/go-shortener/storage/psql/schema"
+)
+
+const (
+	clickTableName = "click"
+)
+
+// AddClicks adds given click objects to storage
+func (st *Storage) AddClicks(ctx context.Context, objs []model.Click) (err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql AddClicks")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(clickTableName), withOperation("AddClicks"))
+
+	dbObjs := schema.NewClicksFromCanonical(objs)
+
+	_, err = st.db.NewInsert().
+		Model(&dbObjs).
+		Exec(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("add clicks: %v", dbObjs)
+		return fmt.Errorf("psql: AddClicks: %w", err)
+	}
+
+	return nil
+}
+
+// GetClickStats gets statistics of the url clicks made in [from, to) time range
+// Clicks are aggregated by the DB, buckets are aligned to UTC.
+func (st *Storage) GetClickStats(
+	ctx context.Context, urlID int, params model.StatsParams, topLimit int) (
+	stats model.URLStats, err error) {
+
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql GetClickStats")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(clickTableName), withOperation("GetClickStats"))
+
+	bucketField := "day"
+	if params.Bucket == time.Hour {
+		bucketField = "hour"
+	}
+
+	err = st.read(ctx, func(db bun.IDB) error {
+		clicksInRange := func() *bun.SelectQuery {
+			return db.NewSelect().
+				Model((*schema.Click)(nil)).
+				Where("url_id = ?", urlID).
+				Where("created_at >= ?", params.From).
+				Where("created_at < ?", params.To)
+		}
+
+		err := clicksInRange().
+			ColumnExpr("count(*)").
+			ColumnExpr("count(DISTINCT ip)").
+			Scan(ctx, &stats.TotalClicks, &stats.UniqueVisitors)
+		if err != nil {
+			return err
+		}
+
+		var buckets []schema.ClickBucket
+		err = clicksInRange().
+			ColumnExpr("date_trunc(?, created_at AT TIME ZONE 'UTC') AS start", bucketField).
+			ColumnExpr("count(*) AS clicks").
+			GroupExpr("start").
+			OrderExpr("start").
+			Scan(ctx, &buckets)
+		if err != nil {
+			return err
+		}
+		stats.Buckets = schema.ClickBuckets(buckets).ToCanonical()
+
+		for _, top := range []struct {
+			column   string
+			counters *[]model.StatsCounter
+		}{
+			{column: "referrer", counters: &stats.TopReferrers},
+			{column: "user_agent", counters: &stats.TopUserAgents},
+		} {
+			var counters []schema.ClickCounter
+			err = clicksInRange().
+				ColumnExpr("? AS value", bun.Ident(top.column)).
+				ColumnExpr("count(*) AS clicks").
+				Where("? <> ''", bun.Ident(top.column)).
+				GroupExpr("value").
+				OrderExpr("clicks DESC, value").
+				Limit(topLimit).
+				Scan(ctx, &counters)
+			if err != nil {
+				return err
+			}
+			*top.counters = schema.ClickCounters(counters).ToCanonical()
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Warn().Err(err).Msgf("get click stats of URL with id: %v", urlID)
+		return model.URLStats{}, fmt.Errorf("psql: GetClickStats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// ListClicks gets at most limit click objects with id greater than afterID ordered by id
+func (st *Storage) ListClicks(ctx context.Context, afterID, limit int) (objs []model.Click, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql ListClicks")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(clickTableName), withOperation("ListClicks"))
+
+	var dbObjs schema.Clicks
+
+	err = st.db.NewSelect().
+		Model(&dbObjs).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("list clicks after id: %v", afterID)
+		return nil, fmt.Errorf("psql: ListClicks: %w", err)
+	}
+
+	return dbObjs.ToCanonical(), nil
+}
+
+// ImportClicks adds given click objects to storage keeping their ids
+// Objects with already stored ids are skipped, so an interrupted import can be repeated.
+// The id sequence is moved past imported ids.
+func (st *Storage) ImportClicks(ctx context.Context, objs []model.Click) (err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql ImportClicks")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(clickTableName), withOperation("ImportClicks"))
+
+	dbObjs := schema.NewClicksFromCanonical(objs)
+	if len(dbObjs) == 0 {
+		return nil
+	}
+
+	err = st.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().
+			Model(&dbObjs).
+			On("CONFLICT (id) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		return syncIDSequence(ctx, tx, clickTableName)
+	})
+	if err != nil {
+		logger.Warn().Err(err).Msgf("import clicks: %v", dbObjs)
+		return fmt.Errorf("psql: ImportClicks: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package psql
+
+import (
+	"time"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+func (s *TestSuite) TestClicks_AddClicks() {
+	s.Run("Add clicks", func() {
+		clicks := []model.Click{
+			{
+				URLID:     s.fixtures.URLS[1].ID,
+				Referrer:  "https://referrer.com/",
+				UserAgent: "Mozilla/5.0",
+				IP:        "127.0.0.1",
+				CreatedAt: time.Now(),
+			},
+		}
+
+		err := s.storage.AddClicks(s.ctx, clicks)
+		s.Require().NoError(err)
+	})
+
+	s.Run("Add clicks of non-existing url", func() {
+		clicks := []model.Click{
+			{
+				URLID:     -1,
+				CreatedAt: time.Now(),
+			},
+		}
+
+		err := s.storage.AddClicks(s.ctx, clicks)
+		s.Require().Error(err)
+	})
+}
+
+func (s *TestSuite) TestClicks_GetClickStats() {
+	now := time.Now().UTC().Truncate(time.Hour)
+	clicks := []model.Click{
+		{
+			URLID:     s.fixtures.URLS[0].ID,
+			Referrer:  "https://referrer.com/",
+			UserAgent: "Mozilla/5.0",
+			IP:        "127.0.0.1",
+			CreatedAt: now.Add(-3 * time.Hour),
+		},
+		{
+			URLID:     s.fixtures.URLS[0].ID,
+			UserAgent: "curl",
+			IP:        "127.0.0.2",
+			CreatedAt: now.Add(-time.Hour),
+		},
+		{
+			URLID:     s.fixtures.URLS[0].ID,
+			Referrer:  "https://referrer.com/",
+			UserAgent: "curl",
+			IP:        "127.0.0.2",
+			CreatedAt: now.Add(-time.Hour + time.Minute),
+		},
+	}
+
+	s.Require().NoError(s.storage.AddClicks(s.ctx, clicks))
+
+	s.Run("Get click stats in time range", func() {
+		params := model.StatsParams{From: now.Add(-2 * time.Hour), To: now, Bucket: time.Hour}
+		res, err := s.storage.GetClickStats(s.ctx, s.fixtures.URLS[0].ID, params, 10)
+		s.Require().NoError(err)
+		s.Assert().Equal(2, res.TotalClicks)
+		s.Assert().Equal(1, res.UniqueVisitors)
+		s.Assert().Equal([]model.StatsBucket{{Start: now.Add(-time.Hour), Clicks: 2}}, res.Buckets)
+		s.Assert().Equal([]model.StatsCounter{{Value: "https://referrer.com/", Clicks: 1}}, res.TopReferrers)
+		s.Assert().Equal([]model.StatsCounter{{Value: "curl", Clicks: 2}}, res.TopUserAgents)
+	})
+
+	s.Run("Get click stats of url without clicks", func() {
+		params := model.StatsParams{From: now.Add(-time.Hour), To: now, Bucket: 24 * time.Hour}
+		res, err := s.storage.GetClickStats(s.ctx, -1, params, 10)
+		s.Require().NoError(err)
+		s.Assert().Zero(res.TotalClicks)
+		s.Assert().Empty(res.Buckets)
+	})
+}
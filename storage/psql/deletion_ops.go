@@ -0,0 +1,220 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg/tracing"
+	"github.com/vstdy/go-shortener/storage/psql/schema"
+)
+
+const (
+	deletionTableName = "deletion"
+)
+
+// AddDeletions adds given pending deletion objects to storage
+func (st *Storage) AddDeletions(ctx context.Context, objs []model.Deletion) (addedObjs []model.Deletion, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql AddDeletions")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(deletionTableName), withOperation("AddDeletions"))
+
+	dbObjs := schema.NewDeletionsFromCanonical(objs)
+	if len(dbObjs) == 0 {
+		return nil, nil
+	}
+	for idx := range dbObjs {
+		if dbObjs[idx].Status == "" {
+			dbObjs[idx].Status = model.DeletionPending
+		}
+	}
+
+	_, err = st.db.NewInsert().
+		Model(&dbObjs).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("add deletions: %v", dbObjs)
+		return nil, fmt.Errorf("psql: AddDeletions: %w", err)
+	}
+
+	return dbObjs.ToCanonical(), nil
+}
+
+// ClaimDueDeletions gets at most limit pending deletion objects to be attempted by given time ordered by id
+// Next attempt of the objects is postponed by given lease, so they are not claimed again until it expires.
+// Rows claimed by concurrent transactions are skipped, so every object is claimed by a single instance.
+func (st *Storage) ClaimDueDeletions(
+	ctx context.Context, now time.Time, limit int, lease time.Duration) (
+	objs []model.Deletion, err error) {
+
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql ClaimDueDeletions")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(deletionTableName), withOperation("ClaimDueDeletions"))
+
+	var dbObjs schema.Deletions
+	leaseUntil := now.Add(lease)
+
+	err = st.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		dbObjs = nil
+		err := tx.NewSelect().
+			Model(&dbObjs).
+			Where("status = ?", model.DeletionPending).
+			Where("dead_at IS NULL").
+			Where("next_attempt_at <= ?", now).
+			Order("id ASC").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil || len(dbObjs) == 0 {
+			return err
+		}
+
+		ids := make([]int, 0, len(dbObjs))
+		for idx := range dbObjs {
+			dbObjs[idx].NextAttemptAt = leaseUntil
+			ids = append(ids, dbObjs[idx].ID)
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*schema.Deletion)(nil)).
+			Set("next_attempt_at = ?", leaseUntil).
+			Where("id IN (?)", bun.In(ids)).
+			Exec(ctx)
+
+		return err
+	})
+	if err != nil {
+		logger.Warn().Err(err).Msgf("claim deletions due by: %v", now)
+		return nil, fmt.Errorf("psql: ClaimDueDeletions: %w", err)
+	}
+
+	return dbObjs.ToCanonical(), nil
+}
+
+// HasPendingDeletions checks whether any deletion object is pending regardless of its next attempt time
+func (st *Storage) HasPendingDeletions(ctx context.Context) (exists bool, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql HasPendingDeletions")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(deletionTableName), withOperation("HasPendingDeletions"))
+
+	exists, err = st.db.NewSelect().
+		Model((*schema.Deletion)(nil)).
+		Where("status = ?", model.DeletionPending).
+		Where("dead_at IS NULL").
+		Exists(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msg("check pending deletions")
+		return false, fmt.Errorf("psql: HasPendingDeletions: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetJobDeletions gets deletion objects of the job with given id ordered by id
+func (st *Storage) GetJobDeletions(ctx context.Context, jobID uuid.UUID) (objs []model.Deletion, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql GetJobDeletions")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(deletionTableName), withOperation("GetJobDeletions"))
+
+	var dbObjs schema.Deletions
+
+	err = st.db.NewSelect().
+		Model(&dbObjs).
+		Where("job_id = ?", jobID).
+		Order("id ASC").
+		Scan(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("get deletions of job: %v", jobID)
+		return nil, fmt.Errorf("psql: GetJobDeletions: %w", err)
+	}
+
+	return dbObjs.ToCanonical(), nil
+}
+
+// UpdateDeletions updates status, attempts, last error, next attempt, done and dead time of given deletion objects
+func (st *Storage) UpdateDeletions(ctx context.Context, objs []model.Deletion) (err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql UpdateDeletions")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(deletionTableName), withOperation("UpdateDeletions"))
+
+	dbObjs := schema.NewDeletionsFromCanonical(objs)
+
+	err = st.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for idx := range dbObjs {
+			_, err := tx.NewUpdate().
+				Model(&dbObjs[idx]).
+				Column("status", "attempts", "last_error", "next_attempt_at", "done_at", "dead_at").
+				WherePK().
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Warn().Err(err).Msgf("update deletions: %v", dbObjs)
+		return fmt.Errorf("psql: UpdateDeletions: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveDoneDeletions removes deletion objects done before given time and returns their number
+func (st *Storage) RemoveDoneDeletions(ctx context.Context, before time.Time) (removed int, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql RemoveDoneDeletions")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(deletionTableName), withOperation("RemoveDoneDeletions"))
+
+	res, err := st.db.NewDelete().
+		Model((*schema.Deletion)(nil)).
+		Where("done_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("remove deletions done before: %v", before)
+		return 0, fmt.Errorf("psql: RemoveDoneDeletions: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("psql: RemoveDoneDeletions: %w", err)
+	}
+
+	return int(rows), nil
+}
+
+// GetDeadDeletions gets at most limit dead deletion objects with id greater than afterID ordered by id
+func (st *Storage) GetDeadDeletions(ctx context.Context, afterID, limit int) (objs []model.Deletion, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql GetDeadDeletions")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(deletionTableName), withOperation("GetDeadDeletions"))
+
+	var dbObjs schema.Deletions
+
+	err = st.db.NewSelect().
+		Model(&dbObjs).
+		Where("dead_at IS NOT NULL").
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("get dead deletions after id: %v", afterID)
+		return nil, fmt.Errorf("psql: GetDeadDeletions: %w", err)
+	}
+
+	return dbObjs.ToCanonical(), nil
+}
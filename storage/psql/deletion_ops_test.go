@@ -0,0 +1,138 @@
+package psql
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+func (s *TestSuite) TestDeletions() {
+	now := time.Now().UTC().Truncate(time.Second)
+	userID, jobID := uuid.New(), uuid.New()
+
+	dels, err := s.storage.AddDeletions(s.ctx, []model.Deletion{
+		{JobID: jobID, UserID: userID, Code: "a1B2c3D", NextAttemptAt: now.Add(-time.Minute)},
+		{JobID: jobID, UserID: userID, Code: "e4F5g6H", NextAttemptAt: now.Add(time.Minute)},
+		{JobID: uuid.New(), UserID: userID, Code: "i7J8k9L", NextAttemptAt: now.Add(-time.Second)},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(dels, 3)
+	for _, deletion := range dels {
+		s.Assert().NotZero(deletion.ID)
+		s.Assert().False(deletion.CreatedAt.IsZero())
+		s.Assert().Equal(model.DeletionPending, deletion.Status)
+	}
+
+	s.Run("Get due deletions", func() {
+		pending, err := s.storage.HasPendingDeletions(s.ctx)
+		s.Require().NoError(err)
+		s.Assert().True(pending)
+
+		res, err := s.storage.ClaimDueDeletions(s.ctx, now, 10, 0)
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		s.Assert().Equal(dels[0].ID, res[0].ID)
+		s.Assert().Equal(dels[2].ID, res[1].ID)
+	})
+
+	s.Run("Move deletion to dead letters", func() {
+		dead := dels[0]
+		dead.Attempts = 3
+		dead.LastError = "connection refused"
+		dead.DeadAt = now
+
+		s.Require().NoError(s.storage.UpdateDeletions(s.ctx, []model.Deletion{dead}))
+
+		res, err := s.storage.ClaimDueDeletions(s.ctx, now, 10, 0)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(dels[2].ID, res[0].ID)
+
+		res, err = s.storage.GetDeadDeletions(s.ctx, 0, 10)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(3, res[0].Attempts)
+		s.Assert().Equal("connection refused", res[0].LastError)
+		s.Assert().True(res[0].DeadAt.Equal(now))
+	})
+
+	s.Run("Get job deletions", func() {
+		res, err := s.storage.GetJobDeletions(s.ctx, jobID)
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		s.Assert().Equal(dels[0].ID, res[0].ID)
+		s.Assert().Equal(dels[1].ID, res[1].ID)
+
+		res, err = s.storage.GetJobDeletions(s.ctx, uuid.New())
+		s.Require().NoError(err)
+		s.Assert().Empty(res)
+	})
+
+	s.Run("Remove done deletions", func() {
+		done := []model.Deletion{dels[1], dels[2]}
+		done[0].Status, done[0].DoneAt = model.DeletionNotOwned, now.Add(-time.Hour)
+		done[1].Status, done[1].DoneAt = model.DeletionDeleted, now
+		s.Require().NoError(s.storage.UpdateDeletions(s.ctx, done))
+
+		res, err := s.storage.ClaimDueDeletions(s.ctx, now.Add(time.Hour), 10, 0)
+		s.Require().NoError(err)
+		s.Assert().Empty(res)
+
+		pending, err := s.storage.HasPendingDeletions(s.ctx)
+		s.Require().NoError(err)
+		s.Assert().False(pending)
+
+		res, err = s.storage.GetJobDeletions(s.ctx, jobID)
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		s.Assert().Equal(model.DeletionNotOwned, res[1].Status)
+		s.Assert().True(res[1].DoneAt.Equal(now.Add(-time.Hour)))
+
+		removed, err := s.storage.RemoveDoneDeletions(s.ctx, now.Add(-time.Minute))
+		s.Require().NoError(err)
+		s.Assert().Equal(1, removed)
+
+		res, err = s.storage.GetJobDeletions(s.ctx, jobID)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(model.DeletionFailed, res[0].Outcome())
+	})
+}
+
+func (s *TestSuite) TestDeletions_Claim() {
+	now := time.Now().UTC().Truncate(time.Second)
+	userID := uuid.New()
+
+	dels, err := s.storage.AddDeletions(s.ctx, []model.Deletion{
+		{JobID: uuid.New(), UserID: userID, Code: "a1B2c3D", NextAttemptAt: now.Add(-time.Minute)},
+		{JobID: uuid.New(), UserID: userID, Code: "e4F5g6H", NextAttemptAt: now.Add(-time.Second)},
+	})
+	s.Require().NoError(err)
+
+	res, err := s.storage.ClaimDueDeletions(s.ctx, now, 1, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Assert().Equal(dels[0].ID, res[0].ID)
+	s.Assert().True(res[0].NextAttemptAt.Equal(now.Add(time.Minute)))
+
+	res, err = s.storage.ClaimDueDeletions(s.ctx, now, 10, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Assert().Equal(dels[1].ID, res[0].ID)
+
+	res, err = s.storage.ClaimDueDeletions(s.ctx, now, 10, time.Minute)
+	s.Require().NoError(err)
+	s.Assert().Empty(res)
+
+	// deletions are claimed again once their lease expires
+	res, err = s.storage.ClaimDueDeletions(s.ctx, now.Add(time.Minute), 10, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(res, 2)
+
+	for idx := range res {
+		res[idx].Status, res[idx].DoneAt = model.DeletionDeleted, now
+	}
+	s.Require().NoError(s.storage.UpdateDeletions(s.ctx, res))
+}
@@ -0,0 +1,2 @@
+-- url table
+DROP TABLE "url";
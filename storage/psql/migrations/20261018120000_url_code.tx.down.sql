@@ -0,0 +1,4 @@
+-- url short code, urls resolve by their numeric id only
+DROP INDEX url_code_idx;
+
+ALTER TABLE "url" DROP COLUMN "code";
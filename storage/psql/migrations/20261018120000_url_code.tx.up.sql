@@ -0,0 +1,8 @@
+-- url short code, existing urls keep resolving by their numeric id
+ALTER TABLE "url" ADD COLUMN "code" VARCHAR;
+
+UPDATE "url" SET "code" = "id"::VARCHAR;
+
+ALTER TABLE "url" ALTER COLUMN "code" SET NOT NULL;
+
+CREATE UNIQUE INDEX url_code_idx ON url (code);
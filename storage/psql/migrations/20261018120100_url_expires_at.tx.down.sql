@@ -0,0 +1,4 @@
+-- url expiration time
+DROP INDEX url_expires_at_idx;
+
+ALTER TABLE "url" DROP COLUMN "expires_at";
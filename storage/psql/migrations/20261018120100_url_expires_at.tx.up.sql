@@ -0,0 +1,4 @@
+-- url expiration time, NULL means the url never expires
+ALTER TABLE "url" ADD COLUMN "expires_at" TIMESTAMPTZ;
+
+CREATE INDEX url_expires_at_idx ON url (expires_at) WHERE expires_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,4 @@
+-- url clicks limit
+ALTER TABLE "url" DROP COLUMN "clicks";
+
+ALTER TABLE "url" DROP COLUMN "max_clicks";
@@ -0,0 +1,4 @@
+-- url clicks limit, zero max_clicks means unlimited clicks
+ALTER TABLE "url" ADD COLUMN "max_clicks" INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE "url" ADD COLUMN "clicks" INTEGER NOT NULL DEFAULT 0;
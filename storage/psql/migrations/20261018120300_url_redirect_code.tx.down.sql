@@ -0,0 +1,2 @@
+-- url redirect status code
+ALTER TABLE "url" DROP COLUMN "redirect_code";
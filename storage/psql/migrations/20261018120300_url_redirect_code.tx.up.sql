@@ -0,0 +1,2 @@
+-- url redirect status code, zero means the default one
+ALTER TABLE "url" ADD COLUMN "redirect_code" SMALLINT NOT NULL DEFAULT 0;
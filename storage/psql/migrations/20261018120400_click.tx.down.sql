@@ -0,0 +1,2 @@
+-- click table
+DROP TABLE "click";
@@ -0,0 +1,13 @@
+-- click table
+CREATE TABLE "click"
+(
+    "id"         BIGSERIAL   NOT NULL,
+    "url_id"     BIGINT      NOT NULL REFERENCES "url" ("id") ON DELETE CASCADE,
+    "referrer"   VARCHAR     NOT NULL,
+    "user_agent" VARCHAR     NOT NULL,
+    "ip"         VARCHAR     NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX click_url_id_created_at_idx ON click (url_id, created_at);
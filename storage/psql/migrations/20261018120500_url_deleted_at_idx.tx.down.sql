@@ -0,0 +1,2 @@
+-- user deleted urls lookup
+DROP INDEX url_user_id_deleted_at_idx;
@@ -0,0 +1,2 @@
+-- user deleted urls lookup for trash listing and restore
+CREATE INDEX url_user_id_deleted_at_idx ON url (user_id, deleted_at) WHERE deleted_at IS NOT NULL;
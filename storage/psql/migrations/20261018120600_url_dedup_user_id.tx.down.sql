@@ -0,0 +1,5 @@
+-- urls are unique globally, fails if any url is shortened by several users
+DROP INDEX url_url_idx;
+CREATE UNIQUE INDEX url_url_idx ON url (url) WHERE deleted_at IS NULL;
+
+ALTER TABLE url DROP COLUMN dedup_user_id;
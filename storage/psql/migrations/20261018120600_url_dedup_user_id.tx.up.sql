@@ -0,0 +1,5 @@
+-- urls are unique per dedup namespace, nil uuid is the global namespace
+ALTER TABLE url ADD COLUMN dedup_user_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+DROP INDEX url_url_idx;
+CREATE UNIQUE INDEX url_url_idx ON url (dedup_user_id, url) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+-- user urls lookup
+DROP INDEX url_user_id_idx;
+CREATE INDEX url_user_id_idx ON url (user_id);
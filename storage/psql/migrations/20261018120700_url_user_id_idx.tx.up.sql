@@ -0,0 +1,3 @@
+-- user urls keyset pagination ordered by id
+DROP INDEX url_user_id_idx;
+CREATE INDEX url_user_id_idx ON url (user_id, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,6 @@
+-- user urls filtering, pg_trgm extension is kept as other database objects may use it
+DROP INDEX url_url_trgm_idx;
+DROP INDEX url_user_id_created_at_idx;
+DROP INDEX url_user_id_domain_idx;
+
+ALTER TABLE url DROP COLUMN domain;
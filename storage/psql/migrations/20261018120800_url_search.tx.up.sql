@@ -0,0 +1,10 @@
+-- user urls filtering by url substring and domain and ordering by creation time
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE url ADD COLUMN domain VARCHAR GENERATED ALWAYS AS (
+    lower(substring(url FROM '^[^:/?#]+://(?:[^@/?#]*@)?([^:/?#]*)'))
+) STORED;
+
+CREATE INDEX url_user_id_domain_idx ON url (user_id, domain) WHERE deleted_at IS NULL;
+CREATE INDEX url_user_id_created_at_idx ON url (user_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX url_url_trgm_idx ON url USING gin (url gin_trgm_ops);
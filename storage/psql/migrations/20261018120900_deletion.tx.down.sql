@@ -0,0 +1,2 @@
+-- deletion table
+DROP TABLE "deletion";
@@ -0,0 +1,16 @@
+-- deletion table
+CREATE TABLE "deletion"
+(
+    "id"              BIGSERIAL   NOT NULL,
+    "user_id"         UUID        NOT NULL,
+    "code"            VARCHAR     NOT NULL,
+    "attempts"        INTEGER     NOT NULL DEFAULT 0,
+    "last_error"      VARCHAR     NOT NULL DEFAULT '',
+    "next_attempt_at" TIMESTAMPTZ NOT NULL,
+    "created_at"      TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    "dead_at"         TIMESTAMPTZ,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX deletion_next_attempt_at_idx ON deletion (next_attempt_at) WHERE dead_at IS NULL;
+CREATE INDEX deletion_dead_idx ON deletion (id) WHERE dead_at IS NOT NULL;
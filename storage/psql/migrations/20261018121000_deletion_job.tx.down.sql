@@ -0,0 +1,15 @@
+-- deletion job and outcome
+DROP INDEX deletion_done_at_idx;
+DROP INDEX deletion_job_id_idx;
+DROP INDEX deletion_next_attempt_at_idx;
+
+DELETE
+FROM "deletion"
+WHERE status <> 'pending';
+
+ALTER TABLE "deletion"
+    DROP COLUMN "done_at",
+    DROP COLUMN "status",
+    DROP COLUMN "job_id";
+
+CREATE INDEX deletion_next_attempt_at_idx ON deletion (next_attempt_at) WHERE dead_at IS NULL;
@@ -0,0 +1,13 @@
+-- deletion job and outcome
+ALTER TABLE "deletion"
+    ADD COLUMN "job_id"  UUID        NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    ADD COLUMN "status"  VARCHAR     NOT NULL DEFAULT 'pending',
+    ADD COLUMN "done_at" TIMESTAMPTZ;
+
+ALTER TABLE "deletion"
+    ALTER COLUMN "job_id" DROP DEFAULT;
+
+DROP INDEX deletion_next_attempt_at_idx;
+CREATE INDEX deletion_next_attempt_at_idx ON deletion (next_attempt_at) WHERE status = 'pending' AND dead_at IS NULL;
+CREATE INDEX deletion_job_id_idx ON deletion (job_id);
+CREATE INDEX deletion_done_at_idx ON deletion (done_at) WHERE done_at IS NOT NULL;
@@ -0,0 +1,3 @@
+-- url as given by the user
+ALTER TABLE "url"
+    DROP COLUMN "input_url";
@@ -0,0 +1,3 @@
+-- url as given by the user
+ALTER TABLE "url"
+    ADD COLUMN "input_url" VARCHAR NOT NULL DEFAULT '';
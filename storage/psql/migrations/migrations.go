@@ -2,10 +2,19 @@ package migrations
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"time"
 
 	"github.com/uptrace/bun/migrate"
 )
 
+const nameTimeFormat = "20060102150405"
+
+var nameRE = regexp.MustCompile(`^[0-9a-z_]+$`)
+
 // GetMigrations returns bun migrations discovered by caller file path.
 func GetMigrations() (*migrate.Migrations, error) {
 	migrations := migrate.NewMigrations()
@@ -15,3 +24,27 @@ func GetMigrations() (*migrate.Migrations, error) {
 
 	return migrations, nil
 }
+
+// Create creates empty transactional up and down migration files with given name
+// next to the existing migrations. Returns paths of the created files.
+func Create(name string) ([]string, error) {
+	if !nameRE.MatchString(name) {
+		return nil, fmt.Errorf("invalid migration name %q: lowercase letters, digits and underscores are allowed", name)
+	}
+
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return nil, fmt.Errorf("resolving migrations directory")
+	}
+
+	prefix := filepath.Join(filepath.Dir(file), time.Now().UTC().Format(nameTimeFormat)+"_"+name)
+	paths := []string{prefix + ".tx.up.sql", prefix + ".tx.down.sql"}
+	for _, path := range paths {
+		content := fmt.Sprintf("-- %s\n", name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return nil, fmt.Errorf("creating migration file: %w", err)
+		}
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,178 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+type (
+	// replica keeps read replica connection along with its health state.
+	replica struct {
+		idx     int
+		db      *bun.DB
+		healthy int32
+	}
+
+	// replicaSet routes read queries among healthy replicas in turn.
+	replicaSet struct {
+		replicas []*replica
+		next     uint32
+		stop     chan struct{}
+		stopOnce sync.Once
+		wg       sync.WaitGroup
+	}
+)
+
+// isHealthy checks whether the replica passed the last health check.
+func (r *replica) isHealthy() bool {
+	return atomic.LoadInt32(&r.healthy) == 1
+}
+
+// setHealthy updates the replica health state, a change is logged.
+func (r *replica) setHealthy(healthy bool, err error) {
+	var value int32
+	if healthy {
+		value = 1
+	}
+
+	if atomic.SwapInt32(&r.healthy, value) == value {
+		return
+	}
+
+	if healthy {
+		log.Info().Int("replica", r.idx).Msg("DB replica is healthy, reads are routed to it")
+	} else {
+		log.Warn().Err(err).Int("replica", r.idx).Msg("DB replica is unhealthy, reads fall back to other DBs")
+	}
+}
+
+// newReplicaSet creates a replicaSet checking replicas health every given interval.
+func newReplicaSet(replicas []*replica, checkInterval time.Duration) *replicaSet {
+	rs := &replicaSet{
+		replicas: replicas,
+		stop:     make(chan struct{}),
+	}
+	if len(replicas) == 0 {
+		return rs
+	}
+
+	rs.checkHealth(checkInterval)
+
+	rs.wg.Add(1)
+	go func() {
+		defer rs.wg.Done()
+
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-rs.stop:
+				return
+			case <-ticker.C:
+				rs.checkHealth(checkInterval)
+			}
+		}
+	}()
+
+	return rs
+}
+
+// checkHealth pings every replica within given timeout.
+func (rs *replicaSet) checkHealth(timeout time.Duration) {
+	for _, r := range rs.replicas {
+		ctx, ctxCancel := context.WithTimeout(context.Background(), timeout)
+		err := r.db.PingContext(ctx)
+		ctxCancel()
+
+		r.setHealthy(err == nil, err)
+	}
+}
+
+// pick returns the next healthy replica, nil is returned if there are none.
+func (rs *replicaSet) pick() *replica {
+	healthy := 0
+	for _, r := range rs.replicas {
+		if r.isHealthy() {
+			healthy++
+		}
+	}
+	if healthy == 0 {
+		return nil
+	}
+
+	skip := int(atomic.AddUint32(&rs.next, 1) % uint32(healthy))
+	for _, r := range rs.replicas {
+		if !r.isHealthy() {
+			continue
+		}
+		if skip == 0 {
+			return r
+		}
+		skip--
+	}
+
+	return nil
+}
+
+// close stops health checks and closes replica connections.
+func (rs *replicaSet) close() error {
+	rs.stopOnce.Do(func() { close(rs.stop) })
+	rs.wg.Wait()
+
+	var retErr error
+	for _, r := range rs.replicas {
+		if err := r.db.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}
+
+	return retErr
+}
+
+// read runs the read query on a healthy replica.
+// The query falls back to the primary if there are no healthy replicas
+// or the picked replica fails to serve it, the replica is marked unhealthy then.
+func (st *Storage) read(ctx context.Context, query func(db bun.IDB) error) error {
+	return st.readOrPrimary(ctx, query, nil)
+}
+
+// readOrPrimary runs the read query like read does and repeats it on the primary
+// if stale reports the replica result may lag behind, e.g. a just added object is not found.
+func (st *Storage) readOrPrimary(ctx context.Context, query func(db bun.IDB) error, stale func(err error) bool) error {
+	r := st.replicas.pick()
+	if r == nil {
+		return query(st.db)
+	}
+
+	err := query(r.db)
+	if isConnError(err) && ctx.Err() == nil {
+		r.setHealthy(false, err)
+		return query(st.db)
+	}
+	if stale != nil && stale(err) && ctx.Err() == nil {
+		return query(st.db)
+	}
+
+	return err
+}
+
+// isConnError checks whether the error is caused by a DB connection failure
+// rather than by a server response.
+func isConnError(err error) bool {
+	if err == nil || errors.Is(err, sql.ErrNoRows) {
+		return false
+	}
+
+	var pgErr pgdriver.Error
+
+	return !errors.As(err, &pgErr)
+}
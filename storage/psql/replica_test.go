@@ -0,0 +1,73 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+func TestReplicaSet_Pick(t *testing.T) {
+	replicas := []*replica{{idx: 0, healthy: 1}, {idx: 1}, {idx: 2, healthy: 1}}
+	rs := &replicaSet{replicas: replicas}
+
+	picked := make(map[int]int)
+	for i := 0; i < 6; i++ {
+		picked[rs.pick().idx]++
+	}
+	assert.Equal(t, map[int]int{0: 3, 2: 3}, picked)
+
+	replicas[0].setHealthy(false, io.EOF)
+	replicas[2].setHealthy(false, io.EOF)
+	assert.Nil(t, rs.pick())
+
+	replicas[1].setHealthy(true, nil)
+	assert.Equal(t, 1, rs.pick().idx)
+
+	assert.Nil(t, (&replicaSet{}).pick())
+}
+
+func TestIsConnError(t *testing.T) {
+	assert.False(t, isConnError(nil))
+	assert.False(t, isConnError(fmt.Errorf("scan: %w", sql.ErrNoRows)))
+	assert.True(t, isConnError(io.ErrUnexpectedEOF))
+}
+
+func TestStorage_ReadOrPrimary(t *testing.T) {
+	newDB := func() *bun.DB {
+		return bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	}
+	primary, replicaDB := newDB(), newDB()
+	st := &Storage{
+		db:       primary,
+		replicas: &replicaSet{replicas: []*replica{{db: replicaDB, healthy: 1}}},
+	}
+
+	// the replica serves the query first, the primary repeats it when the result is stale
+	read := func(replicaErr error, stale func(err error) bool) []bool {
+		var onPrimary []bool
+		err := st.readOrPrimary(context.Background(), func(db bun.IDB) error {
+			onPrimary = append(onPrimary, db == bun.IDB(primary))
+			if db == bun.IDB(primary) {
+				return nil
+			}
+			return replicaErr
+		}, stale)
+		assert.NoError(t, err)
+
+		return onPrimary
+	}
+
+	notFound := func(err error) bool { return err == sql.ErrNoRows }
+
+	assert.Equal(t, []bool{false}, read(nil, notFound))
+	assert.Equal(t, []bool{false, true}, read(sql.ErrNoRows, notFound))
+	assert.Equal(t, []bool{false, true}, read(io.ErrUnexpectedEOF, nil))
+	assert.Nil(t, st.replicas.pick())
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+type (
+	Click struct {
+		bun.BaseModel `bun:"click,alias:c"`
+		ID            int       `bun:"id,pk,autoincrement"`
+		URLID         int       `bun:"url_id,notnull"`
+		Referrer      string    `bun:"referrer,notnull"`
+		UserAgent     string    `bun:"user_agent,notnull"`
+		IP            string    `bun:"ip,notnull"`
+		CreatedAt     time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	}
+
+	Clicks []Click
+
+	// ClickBucket keeps number of clicks made in a time bucket.
+	ClickBucket struct {
+		Start  time.Time `bun:"start"`
+		Clicks int       `bun:"clicks"`
+	}
+
+	ClickBuckets []ClickBucket
+
+	// ClickCounter keeps number of clicks with given value.
+	ClickCounter struct {
+		Value  string `bun:"value"`
+		Clicks int    `bun:"clicks"`
+	}
+
+	ClickCounters []ClickCounter
+)
+
+// NewClicksFromCanonical creates new list of Click DB objects from canonical model.
+func NewClicksFromCanonical(objs []model.Click) Clicks {
+	var clicks Clicks
+	for _, click := range objs {
+		clicks = append(clicks, Click{
+			ID:        click.ID,
+			URLID:     click.URLID,
+			Referrer:  click.Referrer,
+			UserAgent: click.UserAgent,
+			IP:        click.IP,
+			CreatedAt: click.CreatedAt,
+		})
+	}
+
+	return clicks
+}
+
+// ToCanonical converts a DB object to canonical model.
+func (c Click) ToCanonical() model.Click {
+	return model.Click{
+		ID:        c.ID,
+		URLID:     c.URLID,
+		Referrer:  c.Referrer,
+		UserAgent: c.UserAgent,
+		IP:        c.IP,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+// ToCanonical converts DB objects to canonical models.
+func (c Clicks) ToCanonical() []model.Click {
+	objs := make([]model.Click, 0, len(c))
+	for _, obj := range c {
+		objs = append(objs, obj.ToCanonical())
+	}
+
+	return objs
+}
+
+// ToCanonical converts DB objects to canonical models.
+func (b ClickBuckets) ToCanonical() []model.StatsBucket {
+	objs := make([]model.StatsBucket, 0, len(b))
+	for _, bucket := range b {
+		objs = append(objs, model.StatsBucket{Start: bucket.Start.UTC(), Clicks: bucket.Clicks})
+	}
+
+	return objs
+}
+
+// ToCanonical converts DB objects to canonical models.
+func (c ClickCounters) ToCanonical() []model.StatsCounter {
+	objs := make([]model.StatsCounter, 0, len(c))
+	for _, counter := range c {
+		objs = append(objs, model.StatsCounter{Value: counter.Value, Clicks: counter.Clicks})
+	}
+
+	return objs
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+type (
+	Deletion struct {
+		bun.BaseModel `bun:"deletion,alias:d"`
+		ID            int                  `bun:"id,pk,autoincrement"`
+		JobID         uuid.UUID            `bun:"job_id,type:uuid,notnull"`
+		UserID        uuid.UUID            `bun:"user_id,type:uuid,notnull"`
+		Code          string               `bun:"code,notnull"`
+		Status        model.DeletionStatus `bun:"status,notnull"`
+		Attempts      int                  `bun:"attempts,notnull"`
+		LastError     string               `bun:"last_error,notnull"`
+		NextAttemptAt time.Time            `bun:"next_attempt_at,notnull"`
+		CreatedAt     time.Time            `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+		DoneAt        time.Time            `bun:"done_at,nullzero"`
+		DeadAt        time.Time            `bun:"dead_at,nullzero"`
+	}
+
+	Deletions []Deletion
+)
+
+// NewDeletionsFromCanonical creates new list of Deletion DB objects from canonical model.
+func NewDeletionsFromCanonical(objs []model.Deletion) Deletions {
+	var deletions Deletions
+	for _, deletion := range objs {
+		deletions = append(deletions, Deletion{
+			ID:            deletion.ID,
+			JobID:         deletion.JobID,
+			UserID:        deletion.UserID,
+			Code:          deletion.Code,
+			Status:        deletion.Status,
+			Attempts:      deletion.Attempts,
+			LastError:     deletion.LastError,
+			NextAttemptAt: deletion.NextAttemptAt,
+			CreatedAt:     deletion.CreatedAt,
+			DoneAt:        deletion.DoneAt,
+			DeadAt:        deletion.DeadAt,
+		})
+	}
+
+	return deletions
+}
+
+// ToCanonical converts a DB object to canonical model.
+func (d Deletion) ToCanonical() model.Deletion {
+	return model.Deletion{
+		ID:            d.ID,
+		JobID:         d.JobID,
+		UserID:        d.UserID,
+		Code:          d.Code,
+		Status:        d.Status,
+		Attempts:      d.Attempts,
+		LastError:     d.LastError,
+		NextAttemptAt: d.NextAttemptAt,
+		CreatedAt:     d.CreatedAt,
+		DoneAt:        d.DoneAt,
+		DeadAt:        d.DeadAt,
+	}
+}
+
+// ToCanonical converts DB objects to canonical models.
+func (d Deletions) ToCanonical() []model.Deletion {
+	objs := make([]model.Deletion, 0, len(d))
+	for _, obj := range d {
+		objs = append(objs, obj.ToCanonical())
+	}
+
+	return objs
+}
@@ -13,9 +13,17 @@ type (
 	URL struct {
 		bun.BaseModel `bun:"url,alias:u"`
 		ID            int       `bun:"id,pk,autoincrement"`
+		Code          string    `bun:"code,unique,notnull"`
 		CorrelationID string    `bun:"-"`
 		UserID        uuid.UUID `bun:"user_id,type:uuid,notnull"`
-		URL           string    `bun:"url,unique,notnull"`
+		DedupUserID   uuid.UUID `bun:"dedup_user_id,type:uuid,notnull"`
+		URL           string    `bun:"url,notnull"`
+		InputURL      string    `bun:"input_url,notnull"`
+		Domain        string    `bun:"domain,scanonly"`
+		ExpiresAt     time.Time `bun:"expires_at,nullzero"`
+		MaxClicks     int       `bun:"max_clicks,notnull"`
+		Clicks        int       `bun:"clicks,notnull"`
+		RedirectCode  int       `bun:"redirect_code,notnull"`
 		CreatedAt     time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 		UpdatedAt     time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
 		DeletedAt     time.Time `bun:"deleted_at,soft_delete,nullzero"`
@@ -31,9 +39,18 @@ func NewURLsFromCanonical(objs []model.URL) URLS {
 	for _, url := range objs {
 		urls = append(urls, URL{
 			ID:            url.ID,
+			Code:          url.Code,
 			CorrelationID: url.CorrelationID,
 			UserID:        url.UserID,
+			DedupUserID:   url.DedupUserID,
 			URL:           url.URL,
+			InputURL:      url.InputURL,
+			ExpiresAt:     url.ExpiresAt,
+			MaxClicks:     url.MaxClicks,
+			Clicks:        url.Clicks,
+			RedirectCode:  url.RedirectCode,
+			CreatedAt:     url.CreatedAt,
+			DeletedAt:     url.DeletedAt,
 		})
 	}
 
@@ -44,9 +61,18 @@ func NewURLsFromCanonical(objs []model.URL) URLS {
 func (u URL) ToCanonical() model.URL {
 	obj := model.URL{
 		ID:            u.ID,
+		Code:          u.Code,
 		CorrelationID: u.CorrelationID,
 		UserID:        u.UserID,
+		DedupUserID:   u.DedupUserID,
 		URL:           u.URL,
+		InputURL:      u.InputURL,
+		ExpiresAt:     u.ExpiresAt,
+		MaxClicks:     u.MaxClicks,
+		Clicks:        u.Clicks,
+		RedirectCode:  u.RedirectCode,
+		CreatedAt:     u.CreatedAt,
+		DeletedAt:     u.DeletedAt,
 	}
 
 	return obj
@@ -3,8 +3,10 @@ package psql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"runtime"
+	"strings"
+	"time"
 
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
@@ -21,6 +23,8 @@ const (
 
 	dbTableLoggingKey     = "db-table"
 	dbOperationLoggingKey = "db-operation"
+
+	pgUniqueViolationCode = "23505"
 )
 
 var _ inter.Storage = (*Storage)(nil)
@@ -28,12 +32,21 @@ var _ inter.Storage = (*Storage)(nil)
 type (
 	// Storage keeps psql storage dependencies.
 	Storage struct {
-		config Config
-		db     *bun.DB
+		config   Config
+		db       *bun.DB
+		replicas *replicaSet
 	}
 
 	// StorageOption defines functional argument for Storage constructor.
 	StorageOption func(st *Storage) error
+
+	// MigrationStatus keeps state of a DB migration.
+	// Pending migrations have zero group id.
+	MigrationStatus struct {
+		Name       string
+		GroupID    int64
+		MigratedAt time.Time
+	}
 )
 
 // WithConfig overrides default Storage config.
@@ -60,23 +73,52 @@ func NewStorage(opts ...StorageOption) (*Storage, error) {
 		return nil, fmt.Errorf("config validation: %w", err)
 	}
 
-	sqlDB := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(st.config.DSN)))
+	st.db = st.openDB(st.config.DSN)
+	if err := st.db.Ping(); err != nil {
+		return nil, fmt.Errorf("ping for DSN (%s) failed: %w", st.config.DSN, err)
+	}
+
+	replicas := make([]*replica, 0, len(st.config.ReplicaDSNs))
+	for idx, dsn := range st.config.ReplicaDSNs {
+		replicas = append(replicas, &replica{idx: idx, db: st.openDB(dsn)})
+	}
+	st.replicas = newReplicaSet(replicas, st.config.ReplicaCheckInterval)
+
+	return st, nil
+}
+
+// openDB opens DB connections pool with configured limits.
+func (st *Storage) openDB(dsn string) *bun.DB {
+	connOpts := []pgdriver.Option{pgdriver.WithDSN(dsn)}
+	if st.config.StatementTimeout > 0 {
+		connOpts = append(connOpts, withConnParam("statement_timeout", st.config.StatementTimeout.Milliseconds()))
+	}
 
-	maxOpenConnections := 4 * runtime.GOMAXPROCS(0)
+	sqlDB := sql.OpenDB(pgdriver.NewConnector(connOpts...))
+	sqlDB.SetMaxOpenConns(st.config.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(st.config.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(st.config.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(st.config.ConnMaxIdleTime)
 
-	st.db = bun.NewDB(sqlDB, pgdialect.New())
-	st.db.AddQueryHook(newQueryHook(st))
-	st.db.SetMaxOpenConns(maxOpenConnections)
-	st.db.SetMaxIdleConns(maxOpenConnections)
-	st.db.RegisterModel(
+	db := bun.NewDB(sqlDB, pgdialect.New())
+	db.AddQueryHook(newQueryHook(st))
+	db.RegisterModel(
 		(*schema.URL)(nil),
 	)
 
-	if err := st.db.Ping(); err != nil {
-		return nil, fmt.Errorf("ping for DSN (%s) failed: %w", st.config.DSN, err)
-	}
+	return db
+}
 
-	return st, nil
+// withConnParam sets the session parameter of every DB connection.
+func withConnParam(name string, value interface{}) pgdriver.Option {
+	return func(cfg *pgdriver.Config) {
+		params := make(map[string]interface{}, len(cfg.ConnParams)+1)
+		for k, v := range cfg.ConnParams {
+			params[k] = v
+		}
+		params[name] = value
+		cfg.ConnParams = params
+	}
 }
 
 // Close closes DB connection.
@@ -85,6 +127,11 @@ func (st Storage) Close() error {
 		return nil
 	}
 
+	if err := st.replicas.close(); err != nil {
+		st.db.Close()
+		return err
+	}
+
 	return st.db.Close()
 }
 
@@ -92,17 +139,11 @@ func (st Storage) Close() error {
 func (st Storage) Migrate(ctx context.Context) error {
 	logger := st.Logger(ctx, withOperation("migration"))
 
-	ms, err := migrations.GetMigrations()
+	migration, err := st.newMigrator(ctx)
 	if err != nil {
 		return err
 	}
 
-	migration := migrate.NewMigrator(st.db, ms)
-
-	if err = migration.Init(ctx); err != nil {
-		return fmt.Errorf("initialising migration: %w", err)
-	}
-
 	res, err := migration.Migrate(ctx)
 	if err != nil {
 		return fmt.Errorf("performing migration: %w", err)
@@ -113,6 +154,70 @@ func (st Storage) Migrate(ctx context.Context) error {
 	return nil
 }
 
+// Rollback rolls back the last applied group of DB migrations.
+func (st Storage) Rollback(ctx context.Context) error {
+	logger := st.Logger(ctx, withOperation("migration"))
+
+	migration, err := st.newMigrator(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := migration.Rollback(ctx)
+	if err != nil {
+		return fmt.Errorf("rolling back migration: %w", err)
+	}
+
+	if res.IsZero() {
+		logger.Info().Msg("No migrations to roll back")
+		return nil
+	}
+
+	logger.Info().Msgf("Migration rolled back: %s", res.String())
+
+	return nil
+}
+
+// MigrationsStatus returns all known DB migrations in order along with their state.
+func (st Storage) MigrationsStatus(ctx context.Context) ([]MigrationStatus, error) {
+	migration, err := st.newMigrator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ms, err := migration.MigrationsWithStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting migrations status: %w", err)
+	}
+
+	statuses := make([]MigrationStatus, 0, len(ms))
+	for _, m := range ms {
+		statuses = append(statuses, MigrationStatus{
+			Name:       m.Name,
+			GroupID:    m.GroupID,
+			MigratedAt: m.MigratedAt,
+		})
+	}
+
+	return statuses, nil
+}
+
+// newMigrator creates bun migrator of the discovered migrations and initialises its tables.
+func (st Storage) newMigrator(ctx context.Context) (*migrate.Migrator, error) {
+	ms, err := migrations.GetMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	migration := migrate.NewMigrator(st.db, ms)
+
+	if err = migration.Init(ctx); err != nil {
+		return nil, fmt.Errorf("initialising migration: %w", err)
+	}
+
+	return migration, nil
+}
+
 // Ping verifies a connection to the database is still alive.
 func (st *Storage) Ping() error {
 	if err := st.db.Ping(); err != nil {
@@ -121,3 +226,31 @@ func (st *Storage) Ping() error {
 
 	return nil
 }
+
+// isUniqueViolation checks whether the error is a violation of the given unique index.
+func isUniqueViolation(err error, indexName string) bool {
+	var pgErr pgdriver.Error
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Field('C') == pgUniqueViolationCode && pgErr.Field('n') == indexName
+}
+
+// likeEscaper escapes LIKE pattern wildcards.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes the value to be matched literally within a LIKE pattern.
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+// syncIDSequence moves id sequence of the table past the greatest stored id.
+func syncIDSequence(ctx context.Context, tx bun.Tx, table string) error {
+	_, err := tx.ExecContext(ctx,
+		"SELECT setval(pg_get_serial_sequence(?, 'id'), (SELECT COALESCE(MAX(id), 0) + 1 FROM ?), false)",
+		table, bun.Ident(table),
+	)
+
+	return err
+}
@@ -0,0 +1,57 @@
+package psql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vstdy/go-shortener/testutils"
+)
+
+func TestStorage_Rollback(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+	defer ctxCancel()
+
+	c, err := testutils.NewPostgreSQLContainer(ctx)
+	require.NoError(t, err)
+	defer c.Terminate(context.Background())
+
+	stCfg := NewDefaultConfig()
+	stCfg.DSN = c.GetDSN()
+
+	st, err := NewStorage(WithConfig(stCfg))
+	require.NoError(t, err)
+	defer st.Close()
+
+	require.NoError(t, st.Migrate(ctx))
+
+	statuses, err := st.MigrationsStatus(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, statuses)
+	for _, status := range statuses {
+		assert.Equal(t, int64(1), status.GroupID, status.Name)
+	}
+
+	require.NoError(t, st.Rollback(ctx))
+
+	statuses, err = st.MigrationsStatus(ctx)
+	require.NoError(t, err)
+	for _, status := range statuses {
+		assert.Zero(t, status.GroupID, status.Name)
+	}
+
+	var tables []string
+	err = st.db.NewSelect().
+		Table("information_schema.tables").
+		Column("table_name").
+		Where("table_schema = 'public'").
+		Where("table_name IN (?, ?)", "url", "click").
+		Scan(ctx, &tables)
+	require.NoError(t, err)
+	assert.Empty(t, tables)
+
+	require.NoError(t, st.Migrate(ctx))
+}
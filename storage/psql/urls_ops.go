@@ -5,8 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/uptrace/bun"
 
 	"github.com/vstdy/go-shortener/model"
 	"github.com/vstdy/go-shortener/pkg"
@@ -14,7 +17,12 @@ import (
 	"github.com/vstdy/go-shortener/storage/psql/schema"
 )
 
-const tableName = "url"
+const (
+	tableName = "url"
+
+	codeIndexName = "url_code_idx"
+	urlIndexName  = "url_url_idx"
+)
 
 // HasURL checks existence of the url object with given id
 func (st *Storage) HasURL(ctx context.Context, id int) (exists bool, err error) {
@@ -23,10 +31,13 @@ func (st *Storage) HasURL(ctx context.Context, id int) (exists bool, err error)
 
 	logger := st.Logger(ctx, withTable(tableName), withOperation("HasURL"))
 
-	exists, err = st.db.NewSelect().
-		Model(&schema.URL{}).
-		Where("id = ?", id).
-		Exists(ctx)
+	err = st.read(ctx, func(db bun.IDB) error {
+		exists, err = db.NewSelect().
+			Model(&schema.URL{}).
+			Where("id = ?", id).
+			Exists(ctx)
+		return err
+	})
 	if err != nil {
 		logger.Warn().Err(err).Msgf("check existence of URL with id: %v", id)
 		return false, fmt.Errorf("psql: HasURL: %w", err)
@@ -36,25 +47,62 @@ func (st *Storage) HasURL(ctx context.Context, id int) (exists bool, err error)
 }
 
 // AddURLs adds given url objects to storage
-func (st *Storage) AddURLs(ctx context.Context, objs []model.URL) (retObjs []model.URL, err error) {
+// Objects with already stored urls are not added, stored objects are returned instead
+// along with ErrAlreadyExists. Objects repeating a url within the batch share the added object.
+// With user dedup scope urls are deduplicated among objects of the same user only.
+func (st *Storage) AddURLs(ctx context.Context, objs []model.URL, scope model.DedupScope) (retObjs []model.URL, err error) {
 	ctx, span := tracing.StartSpanFromCtx(ctx, "psql AddURLs")
 	defer tracing.FinishSpan(span, err)
 
 	logger := st.Logger(ctx, withTable(tableName), withOperation("AddURLs"))
 
 	dbObjs := schema.NewURLsFromCanonical(objs)
+	if scope == model.DedupScopeUser {
+		for idx := range dbObjs {
+			dbObjs[idx].DedupUserID = dbObjs[idx].UserID
+		}
+	}
+
+	// upsert can't affect a row twice, so repeated urls are inserted once
+	type urlKey struct {
+		dedupUserID uuid.UUID
+		url         string
+	}
+	var uniqueObjs schema.URLS
+	uniqueIdx := make([]int, len(dbObjs))
+	batchURLs := make(map[urlKey]int, len(dbObjs))
+	for idx, obj := range dbObjs {
+		key := urlKey{dedupUserID: obj.DedupUserID, url: obj.URL}
+		pos, ok := batchURLs[key]
+		if !ok {
+			pos = len(uniqueObjs)
+			batchURLs[key] = pos
+			uniqueObjs = append(uniqueObjs, obj)
+		}
+		uniqueIdx[idx] = pos
+	}
 
 	_, err = st.db.NewInsert().
-		Model(&dbObjs).
-		On("CONFLICT (url) WHERE deleted_at IS NULL DO UPDATE").
+		Model(&uniqueObjs).
+		On("CONFLICT (dedup_user_id, url) WHERE deleted_at IS NULL DO UPDATE").
 		Set("updated_at=NOW()").
 		Returning("*, created_at <> updated_at AS updated").
 		Exec(ctx)
 	if err != nil {
-		logger.Warn().Err(err).Msgf("add URLs: %v", dbObjs)
+		if isUniqueViolation(err, codeIndexName) {
+			return nil, fmt.Errorf("psql: AddURLs: %w", pkg.ErrCodeTaken)
+		}
+
+		logger.Warn().Err(err).Msgf("add URLs: %v", uniqueObjs)
 		return nil, fmt.Errorf("psql: AddURLs: %w", err)
 	}
 
+	for idx := range dbObjs {
+		correlationID := dbObjs[idx].CorrelationID
+		dbObjs[idx] = uniqueObjs[uniqueIdx[idx]]
+		dbObjs[idx].CorrelationID = correlationID
+	}
+
 	retObjs, err = dbObjs.ToCanonical()
 	if err != nil {
 		return nil, fmt.Errorf("psql: AddURLs: converting to canonical: %w", err)
@@ -69,25 +117,35 @@ func (st *Storage) AddURLs(ctx context.Context, objs []model.URL) (retObjs []mod
 	return retObjs, nil
 }
 
-// GetURL gets url object with given id
-func (st *Storage) GetURL(ctx context.Context, id int) (obj model.URL, err error) {
+// GetURL gets url object with given short code
+// Objects missing or gone on a replica are read from the primary DB,
+// so just added or restored urls are redirected regardless of replication lag.
+func (st *Storage) GetURL(ctx context.Context, code string) (obj model.URL, err error) {
 	ctx, span := tracing.StartSpanFromCtx(ctx, "psql GetURL")
 	defer tracing.FinishSpan(span, err)
 
 	logger := st.Logger(ctx, withTable(tableName), withOperation("GetURL"))
 
-	dbObj := schema.URL{}
+	var dbObj schema.URL
 
-	err = st.db.NewSelect().
-		Model(&dbObj).
-		Where("id = ?", id).
-		Scan(ctx)
+	query := func(db bun.IDB) error {
+		dbObj = schema.URL{}
+		return db.NewSelect().
+			Model(&dbObj).
+			Where("code = ?", code).
+			Scan(ctx)
+	}
+	stale := func(err error) bool {
+		return errors.Is(err, sql.ErrNoRows) || err == nil && isGone(dbObj, time.Now())
+	}
+
+	err = st.readOrPrimary(ctx, query, stale)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return model.URL{}, nil
 		}
 
-		logger.Warn().Err(err).Msgf("get URL with id: %v", id)
+		logger.Warn().Err(err).Msgf("get URL with code: %v", code)
 		return model.URL{}, fmt.Errorf("psql: GetURL: %w", err)
 	}
 
@@ -96,8 +154,44 @@ func (st *Storage) GetURL(ctx context.Context, id int) (obj model.URL, err error
 	return obj, nil
 }
 
-// GetUsersURLs gets current user url objects
-func (st *Storage) GetUsersURLs(ctx context.Context, userID uuid.UUID) (objs []model.URL, err error) {
+// RedeemURL counts a click of the url object with given short code
+// Objects out of clicks or expired by the time of redeem are not counted.
+func (st *Storage) RedeemURL(ctx context.Context, code string) (obj model.URL, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql RedeemURL")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(tableName), withOperation("RedeemURL"))
+
+	dbObj := schema.URL{}
+
+	res, err := st.db.NewUpdate().
+		Model(&dbObj).
+		Set("clicks = clicks + 1").
+		Where("code = ?", code).
+		Where("max_clicks = 0 OR clicks < max_clicks").
+		Where("expires_at IS NULL OR expires_at > NOW()").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("redeem URL with code: %v", code)
+		return model.URL{}, fmt.Errorf("psql: RedeemURL: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return model.URL{}, fmt.Errorf("psql: RedeemURL: %w", err)
+	}
+	if affected == 0 {
+		return model.URL{}, nil
+	}
+
+	obj = dbObj.ToCanonical()
+
+	return obj, nil
+}
+
+// GetUsersURLs gets current user url objects matching given query
+func (st *Storage) GetUsersURLs(ctx context.Context, userID uuid.UUID, query model.URLsQuery) (objs []model.URL, err error) {
 	ctx, span := tracing.StartSpanFromCtx(ctx, "psql GetUsersURLs")
 	defer tracing.FinishSpan(span, err)
 
@@ -105,13 +199,136 @@ func (st *Storage) GetUsersURLs(ctx context.Context, userID uuid.UUID) (objs []m
 
 	var dbObjs schema.URLS
 
+	err = st.read(ctx, func(db bun.IDB) error {
+		dbObjs = nil
+		return newUsersURLsQuery(db, &dbObjs, userID, query).Scan(ctx)
+	})
+	if err != nil {
+		logger.Warn().Err(err).Msgf("get URLs of user with id: %v", userID)
+		return nil, fmt.Errorf("psql: GetUsersURLs: %w", err)
+	}
+	if dbObjs == nil {
+		return nil, nil
+	}
+
+	objs, err = dbObjs.ToCanonical()
+	if err != nil {
+		return nil, fmt.Errorf("psql: GetUsersURLs: converting to canonical: %w", err)
+	}
+
+	return objs, nil
+}
+
+// newUsersURLsQuery builds select query of current user url objects matching given query.
+func newUsersURLsQuery(db bun.IDB, dbObjs *schema.URLS, userID uuid.UUID, query model.URLsQuery) *bun.SelectQuery {
+	q := db.NewSelect().
+		Model(dbObjs).
+		Where("user_id = ?", userID)
+
+	if query.Filter.Search != "" {
+		q.Where("url ILIKE ?", "%"+escapeLike(query.Filter.Search)+"%")
+	}
+	if query.Filter.Domain != "" {
+		domain := strings.ToLower(query.Filter.Domain)
+		q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("domain = ?", domain).
+				WhereOr("domain LIKE ?", "%."+escapeLike(domain))
+		})
+	}
+	if !query.Filter.CreatedFrom.IsZero() {
+		q.Where("created_at >= ?", query.Filter.CreatedFrom)
+	}
+	if !query.Filter.CreatedTo.IsZero() {
+		q.Where("created_at < ?", query.Filter.CreatedTo)
+	}
+
+	cmp, order := ">", "ASC"
+	if query.Desc {
+		cmp, order = "<", "DESC"
+	}
+
+	if query.Sort == model.ListSortCreatedAt {
+		if query.AfterID != 0 {
+			q.Where("(created_at, id) "+cmp+" (?, ?)", query.AfterCreatedAt, query.AfterID)
+		}
+		q.Order("created_at " + order)
+	} else if query.AfterID != 0 {
+		q.Where("id "+cmp+" ?", query.AfterID)
+	}
+
+	return q.Order("id " + order).
+		Limit(query.Limit)
+}
+
+// UpdateURL updates current user url object with given short code
+func (st *Storage) UpdateURL(ctx context.Context, obj model.URL) (retObj model.URL, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql UpdateURL")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(tableName), withOperation("UpdateURL"))
+
+	dbObj := schema.URL{}
+
+	query := st.db.NewUpdate().
+		Model(&dbObj).
+		Set("updated_at = current_timestamp").
+		Where("code = ?", obj.Code).
+		Where("user_id = ?", obj.UserID).
+		Returning("*")
+	if obj.URL != "" {
+		query = query.Set("url = ?", obj.URL)
+		query = query.Set("input_url = ?", obj.InputURL)
+	}
+	if obj.RedirectCode != 0 {
+		query = query.Set("redirect_code = ?", obj.RedirectCode)
+	}
+
+	res, err := query.Exec(ctx)
+	if err != nil {
+		if isUniqueViolation(err, urlIndexName) {
+			return model.URL{}, fmt.Errorf("psql: UpdateURL: %w", pkg.ErrAlreadyExists)
+		}
+
+		logger.Warn().Err(err).Msgf("update URL with code: %v", obj.Code)
+		return model.URL{}, fmt.Errorf("psql: UpdateURL: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return model.URL{}, fmt.Errorf("psql: UpdateURL: %w", err)
+	}
+	if affected == 0 {
+		return model.URL{}, nil
+	}
+
+	retObj = dbObj.ToCanonical()
+
+	return retObj, nil
+}
+
+// GetUsersDeletedURLs gets current user url objects deleted since given time
+func (st *Storage) GetUsersDeletedURLs(
+	ctx context.Context, userID uuid.UUID, since time.Time) (
+	objs []model.URL, err error) {
+
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql GetUsersDeletedURLs")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(tableName), withOperation("GetUsersDeletedURLs"))
+
+	var dbObjs schema.URLS
+
 	err = st.db.NewSelect().
 		Model(&dbObjs).
+		WhereDeleted().
 		Where("user_id = ?", userID).
+		Where("deleted_at >= ?", since).
+		Where("expires_at IS NULL OR expires_at > current_timestamp").
+		Order("deleted_at DESC").
 		Scan(ctx)
 	if err != nil {
-		logger.Warn().Err(err).Msgf("get URLs of user with id: %v", userID)
-		return nil, fmt.Errorf("psql: GetUsersURLs: %w", err)
+		logger.Warn().Err(err).Msgf("get deleted URLs of user with id: %v", userID)
+		return nil, fmt.Errorf("psql: GetUsersDeletedURLs: %w", err)
 	}
 	if dbObjs == nil {
 		return nil, nil
@@ -119,29 +336,231 @@ func (st *Storage) GetUsersURLs(ctx context.Context, userID uuid.UUID) (objs []m
 
 	objs, err = dbObjs.ToCanonical()
 	if err != nil {
-		return nil, fmt.Errorf("psql: GetUsersURLs: converting to canonical: %w", err)
+		return nil, fmt.Errorf("psql: GetUsersDeletedURLs: converting to canonical: %w", err)
 	}
 
 	return objs, nil
 }
 
-// RemoveUsersURLs removes current user url objects with given ids
-func (st *Storage) RemoveUsersURLs(ctx context.Context, objs []model.URL) (err error) {
+// RestoreURL restores current user url object with given short code deleted since given time
+func (st *Storage) RestoreURL(ctx context.Context, obj model.URL, since time.Time) (retObj model.URL, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql RestoreURL")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(tableName), withOperation("RestoreURL"))
+
+	dbObj := schema.URL{}
+
+	res, err := st.db.NewUpdate().
+		Model(&dbObj).
+		WhereDeleted().
+		Set("deleted_at = NULL").
+		Set("updated_at = current_timestamp").
+		Where("code = ?", obj.Code).
+		Where("user_id = ?", obj.UserID).
+		Where("deleted_at >= ?", since).
+		Where("expires_at IS NULL OR expires_at > current_timestamp").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		if isUniqueViolation(err, urlIndexName) {
+			return model.URL{}, fmt.Errorf("psql: RestoreURL: %w", pkg.ErrAlreadyExists)
+		}
+
+		logger.Warn().Err(err).Msgf("restore URL with code: %v", obj.Code)
+		return model.URL{}, fmt.Errorf("psql: RestoreURL: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return model.URL{}, fmt.Errorf("psql: RestoreURL: %w", err)
+	}
+	if affected == 0 {
+		return model.URL{}, nil
+	}
+
+	retObj = dbObj.ToCanonical()
+
+	return retObj, nil
+}
+
+// RemoveUsersURLs removes current user url objects with given short codes
+// Stored objects are locked to report deletion status of every given object,
+// objects already removed by the user are reported deleted.
+func (st *Storage) RemoveUsersURLs(ctx context.Context, objs []model.URL) (statuses []model.DeletionStatus, err error) {
 	ctx, span := tracing.StartSpanFromCtx(ctx, "psql RemoveUsersURLs")
 	defer tracing.FinishSpan(span, err)
 
 	logger := st.Logger(ctx, withTable(tableName), withOperation("RemoveUsersURLs"))
 
 	dbObjs := schema.NewURLsFromCanonical(objs)
+	if len(dbObjs) == 0 {
+		return nil, nil
+	}
 
-	_, err = st.db.NewDelete().
-		Model(&dbObjs).
-		WherePK("id", "user_id").
-		Exec(ctx)
+	codes := make([]string, 0, len(dbObjs))
+	for _, obj := range dbObjs {
+		codes = append(codes, obj.Code)
+	}
+
+	err = st.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var storedObjs schema.URLS
+		err := tx.NewSelect().
+			Model(&storedObjs).
+			Column("id", "code", "user_id", "deleted_at").
+			WhereAllWithDeleted().
+			Where("code IN (?)", bun.In(codes)).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		stored := make(map[string]schema.URL, len(storedObjs))
+		for _, obj := range storedObjs {
+			stored[obj.Code] = obj
+		}
+
+		statuses = make([]model.DeletionStatus, 0, len(dbObjs))
+		var ids []int
+		for _, obj := range dbObjs {
+			url, ok := stored[obj.Code]
+			status := deletionStatus(url, ok, obj.UserID)
+			statuses = append(statuses, status)
+			if status == model.DeletionDeleted && url.DeletedAt.IsZero() {
+				ids = append(ids, url.ID)
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		_, err = tx.NewDelete().
+			Model((*schema.URL)(nil)).
+			Where("id IN (?)", bun.In(ids)).
+			Exec(ctx)
+
+		return err
+	})
 	if err != nil {
 		logger.Warn().Err(err).Msgf("remove following objects: %v", dbObjs)
-		return fmt.Errorf("psql: RemoveUsersURLs: %w", err)
+		return nil, fmt.Errorf("psql: RemoveUsersURLs: %w", err)
+	}
+
+	return statuses, nil
+}
+
+// deletionStatus returns the outcome of the url object deletion by given user.
+// Objects removed by other users are not found.
+func deletionStatus(url schema.URL, ok bool, userID uuid.UUID) model.DeletionStatus {
+	switch {
+	case !ok:
+		return model.DeletionNotFound
+	case url.UserID == userID:
+		return model.DeletionDeleted
+	case url.DeletedAt.IsZero():
+		return model.DeletionNotOwned
+	default:
+		return model.DeletionNotFound
+	}
+}
+
+// RemoveExpiredURLs removes url objects expired by given time
+func (st *Storage) RemoveExpiredURLs(ctx context.Context, before time.Time) (cnt int, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql RemoveExpiredURLs")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(tableName), withOperation("RemoveExpiredURLs"))
+
+	res, err := st.db.NewDelete().
+		Model((*schema.URL)(nil)).
+		Where("expires_at <= ?", before).
+		Exec(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("remove objects expired by: %v", before)
+		return 0, fmt.Errorf("psql: RemoveExpiredURLs: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("psql: RemoveExpiredURLs: %w", err)
+	}
+
+	return int(affected), nil
+}
+
+// ListURLs gets at most limit url objects with id greater than afterID ordered by id
+// Removed objects are listed as well.
+func (st *Storage) ListURLs(ctx context.Context, afterID, limit int) (objs []model.URL, err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql ListURLs")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(tableName), withOperation("ListURLs"))
+
+	var dbObjs schema.URLS
+
+	err = st.db.NewSelect().
+		Model(&dbObjs).
+		WhereAllWithDeleted().
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("list URLs after id: %v", afterID)
+		return nil, fmt.Errorf("psql: ListURLs: %w", err)
+	}
+
+	objs, err = dbObjs.ToCanonical()
+	if err != nil {
+		return nil, fmt.Errorf("psql: ListURLs: converting to canonical: %w", err)
+	}
+
+	return objs, nil
+}
+
+// ImportURLs adds given url objects to storage keeping their ids
+// Objects with already stored ids are skipped, so an interrupted import can be repeated.
+// The id sequence is moved past imported ids.
+func (st *Storage) ImportURLs(ctx context.Context, objs []model.URL) (err error) {
+	ctx, span := tracing.StartSpanFromCtx(ctx, "psql ImportURLs")
+	defer tracing.FinishSpan(span, err)
+
+	logger := st.Logger(ctx, withTable(tableName), withOperation("ImportURLs"))
+
+	dbObjs := schema.NewURLsFromCanonical(objs)
+	if len(dbObjs) == 0 {
+		return nil
+	}
+
+	err = st.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().
+			Model(&dbObjs).
+			On("CONFLICT (id) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		return syncIDSequence(ctx, tx, tableName)
+	})
+	if err != nil {
+		switch {
+		case isUniqueViolation(err, codeIndexName):
+			return fmt.Errorf("psql: ImportURLs: %w", pkg.ErrCodeTaken)
+		case isUniqueViolation(err, urlIndexName):
+			return fmt.Errorf("psql: ImportURLs: %w", pkg.ErrAlreadyExists)
+		}
+
+		logger.Warn().Err(err).Msgf("import URLs: %v", dbObjs)
+		return fmt.Errorf("psql: ImportURLs: %w", err)
 	}
 
 	return nil
 }
+
+// isGone checks whether the url object is expired or out of clicks by given time.
+func isGone(url schema.URL, now time.Time) bool {
+	return !url.ExpiresAt.IsZero() && !url.ExpiresAt.After(now) ||
+		url.MaxClicks > 0 && url.Clicks >= url.MaxClicks
+}
@@ -2,6 +2,7 @@ package psql
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -28,20 +29,23 @@ func (s *TestSuite) TestURLs_HasURL() {
 func (s *TestSuite) TestURLs_AddURLs() {
 	urlsToAdd := []model.URL{
 		{
+			Code:   "h7I8j9K",
 			UserID: uuid.New(),
 			URL:    "https://lengthy-url-3.com/",
 		},
 		{
+			Code:   "l0M1n2O",
 			UserID: uuid.New(),
 			URL:    "https://lengthy-url-4.com/",
 		},
 	}
 
 	s.Run("Add non-existing urls", func() {
-		res, err := s.storage.AddURLs(s.ctx, urlsToAdd)
+		res, err := s.storage.AddURLs(s.ctx, urlsToAdd, model.DedupScopeGlobal)
 		s.Require().NoError(err)
 
 		for idx := range urlsToAdd {
+			s.Assert().EqualValues(urlsToAdd[idx].Code, res[idx].Code)
 			s.Assert().EqualValues(urlsToAdd[idx].UserID, res[idx].UserID)
 			s.Assert().EqualValues(urlsToAdd[idx].URL, res[idx].URL)
 			s.Assert().NotEqual(0, res[idx].ID)
@@ -52,16 +56,75 @@ func (s *TestSuite) TestURLs_AddURLs() {
 		existingURL, err := s.fixtures.URLS.ToCanonical()
 		s.Require().NoError(err)
 
-		res, err := s.storage.AddURLs(s.ctx, existingURL)
+		res, err := s.storage.AddURLs(s.ctx, existingURL, model.DedupScopeGlobal)
 		s.Require().Error(err)
 		s.Require().True(errors.Is(err, pkg.ErrAlreadyExists))
 		s.Assert().EqualValues(existingURL[0].ID, res[0].ID)
 	})
+
+	s.Run("Add url with taken short code", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   s.fixtures.URLS[0].Code,
+				UserID: uuid.New(),
+				URL:    "https://lengthy-url-5.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().Error(err)
+		s.Require().True(errors.Is(err, pkg.ErrCodeTaken))
+		s.Assert().Nil(res)
+	})
+
+	s.Run("Add batch repeating url", func() {
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:          "q6R7s8T",
+				CorrelationID: "1",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-16.com/",
+			},
+			{
+				Code:          "u9V0w1X",
+				CorrelationID: "2",
+				UserID:        uuid.New(),
+				URL:           "https://lengthy-url-16.com/",
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+		s.Require().Len(res, 2)
+		s.Assert().Equal(res[0].ID, res[1].ID)
+		s.Assert().Equal("q6R7s8T", res[1].Code)
+		s.Assert().Equal("2", res[1].CorrelationID)
+	})
+
+	s.Run("Add existing url with user scope", func() {
+		userID := uuid.New()
+		res, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "r3S4t5U",
+				UserID: userID,
+				URL:    urlsToAdd[0].URL,
+			},
+		}, model.DedupScopeUser)
+		s.Require().NoError(err)
+		s.Assert().EqualValues("r3S4t5U", res[0].Code)
+
+		res, err = s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "v6W7x8Y",
+				UserID: userID,
+				URL:    urlsToAdd[0].URL,
+			},
+		}, model.DedupScopeUser)
+		s.Require().Error(err)
+		s.Require().True(errors.Is(err, pkg.ErrAlreadyExists))
+		s.Assert().EqualValues("r3S4t5U", res[0].Code)
+	})
 }
 
 func (s *TestSuite) TestURLs_GetURL() {
 	s.Run("Get non-existing url", func() {
-		res, err := s.storage.GetURL(s.ctx, 10)
+		res, err := s.storage.GetURL(s.ctx, "non-existing")
 		s.Require().NoError(err)
 		s.Require().EqualValues(model.URL{}, res)
 	})
@@ -69,15 +132,111 @@ func (s *TestSuite) TestURLs_GetURL() {
 	s.Run("Get existing url", func() {
 		expectedURL := s.fixtures.URLS[0].ToCanonical()
 
-		res, err := s.storage.GetURL(s.ctx, expectedURL.ID)
+		res, err := s.storage.GetURL(s.ctx, expectedURL.Code)
 		s.Require().NoError(err)
 		s.Assert().EqualValues(expectedURL, res)
 	})
 }
 
+func (s *TestSuite) TestURLs_RedeemURL() {
+	oneTimeURLs := []model.URL{
+		{
+			Code:      "t6U7v8W",
+			UserID:    uuid.New(),
+			URL:       "https://lengthy-url-7.com/",
+			MaxClicks: 1,
+		},
+	}
+
+	s.Run("Redeem one-time url", func() {
+		_, err := s.storage.AddURLs(s.ctx, oneTimeURLs, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+
+		res, err := s.storage.RedeemURL(s.ctx, oneTimeURLs[0].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(oneTimeURLs[0].URL, res.URL)
+		s.Assert().Equal(1, res.Clicks)
+	})
+
+	s.Run("Redeem url out of clicks", func() {
+		res, err := s.storage.RedeemURL(s.ctx, oneTimeURLs[0].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Redeem expired url", func() {
+		expiredURLs := []model.URL{
+			{
+				Code:      "b2C3d4E",
+				UserID:    uuid.New(),
+				URL:       "https://lengthy-url-17.com/",
+				MaxClicks: 5,
+				ExpiresAt: time.Now().Add(-time.Minute),
+			},
+		}
+		_, err := s.storage.AddURLs(s.ctx, expiredURLs, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+
+		res, err := s.storage.RedeemURL(s.ctx, expiredURLs[0].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Redeem non-existing url", func() {
+		res, err := s.storage.RedeemURL(s.ctx, "non-existing")
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+}
+
+func (s *TestSuite) TestURLs_UpdateURL() {
+	urlsToUpdate := []model.URL{
+		{
+			Code:   "x9Y0z1A",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-8.com/",
+		},
+	}
+
+	s.Run("Update url by creator", func() {
+		_, err := s.storage.AddURLs(s.ctx, urlsToUpdate, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+
+		res, err := s.storage.UpdateURL(s.ctx, model.URL{
+			Code:         urlsToUpdate[0].Code,
+			UserID:       urlsToUpdate[0].UserID,
+			URL:          "https://lengthy-url-9.com/",
+			RedirectCode: 301,
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal("https://lengthy-url-9.com/", res.URL)
+		s.Assert().Equal(301, res.RedirectCode)
+	})
+
+	s.Run("Update url by non-creator", func() {
+		res, err := s.storage.UpdateURL(s.ctx, model.URL{
+			Code:   urlsToUpdate[0].Code,
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-10.com/",
+		})
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Update url to already shortened one", func() {
+		_, err := s.storage.UpdateURL(s.ctx, model.URL{
+			Code:   urlsToUpdate[0].Code,
+			UserID: urlsToUpdate[0].UserID,
+			URL:    s.fixtures.URLS[1].URL,
+		})
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+	})
+}
+
 func (s *TestSuite) TestURLs_GetUserURLs() {
 	s.Run("Get non-existing user urls", func() {
-		res, err := s.storage.GetUsersURLs(s.ctx, uuid.New())
+		res, err := s.storage.GetUsersURLs(s.ctx, uuid.New(), model.URLsQuery{Limit: 100})
 		s.Require().NoError(err)
 		s.Require().Nil(res)
 	})
@@ -86,41 +245,215 @@ func (s *TestSuite) TestURLs_GetUserURLs() {
 		existingURLs, err := s.fixtures.URLS.ToCanonical()
 		s.Require().NoError(err)
 
-		res, err := s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID)
+		res, err := s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID, model.URLsQuery{Limit: 100})
 		s.Require().NoError(err)
 		s.Assert().EqualValues(existingURLs, res)
 	})
+
+	s.Run("Get user urls page", func() {
+		existingURLs, err := s.fixtures.URLS.ToCanonical()
+		s.Require().NoError(err)
+
+		res, err := s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID, model.URLsQuery{Limit: 1})
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().EqualValues(existingURLs[0], res[0])
+
+		res, err = s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID, model.URLsQuery{
+			AfterID: existingURLs[len(existingURLs)-1].ID,
+			Limit:   1,
+		})
+		s.Require().NoError(err)
+		s.Assert().Empty(res)
+	})
+
+	s.Run("Get filtered user urls", func() {
+		existingURLs, err := s.fixtures.URLS.ToCanonical()
+		s.Require().NoError(err)
+
+		res, err := s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID, model.URLsQuery{
+			Filter: model.URLsFilter{Search: "URL-2", Domain: "lengthy-url-2.com"},
+			Limit:  100,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(existingURLs[1].ID, res[0].ID)
+
+		res, err = s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID, model.URLsQuery{
+			Filter: model.URLsFilter{Domain: "url-2.com"},
+			Limit:  100,
+		})
+		s.Require().NoError(err)
+		s.Assert().Empty(res)
+
+		res, err = s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID, model.URLsQuery{
+			Filter: model.URLsFilter{CreatedTo: existingURLs[0].CreatedAt},
+			Limit:  100,
+		})
+		s.Require().NoError(err)
+		s.Assert().Empty(res)
+	})
+
+	s.Run("Get user urls in descending creation order", func() {
+		existingURLs, err := s.fixtures.URLS.ToCanonical()
+		s.Require().NoError(err)
+
+		query := model.URLsQuery{Sort: model.ListSortCreatedAt, Desc: true, Limit: 1}
+		res, err := s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID, query)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(existingURLs[1].ID, res[0].ID)
+
+		query.AfterID, query.AfterCreatedAt = res[0].ID, res[0].CreatedAt
+		res, err = s.storage.GetUsersURLs(s.ctx, existingURLs[0].UserID, query)
+		s.Require().NoError(err)
+		s.Require().Len(res, 1)
+		s.Assert().Equal(existingURLs[0].ID, res[0].ID)
+	})
 }
 
 func (s *TestSuite) TestURLs_RemoveUserURLs() {
 	userURLs := []model.URL{
 		{
-			ID:     1,
+			Code:   s.fixtures.URLS[0].Code,
 			UserID: s.fixtures.URLS[0].UserID,
 		},
 	}
 	foreignURLs := []model.URL{
 		{
-			ID:     2,
+			Code:   s.fixtures.URLS[1].Code,
 			UserID: uuid.New(),
 		},
 	}
 
 	s.Run("Remove urls by creator", func() {
-		err := s.storage.RemoveUsersURLs(s.ctx, userURLs)
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, userURLs)
 		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{model.DeletionDeleted}, statuses)
 
-		res, err := s.storage.GetURL(s.ctx, 1)
+		res, err := s.storage.GetURL(s.ctx, s.fixtures.URLS[0].Code)
 		s.Require().NoError(err)
 		s.Require().EqualValues(model.URL{}, res)
 	})
 
 	s.Run("Remove urls by non-creator", func() {
-		err := s.storage.RemoveUsersURLs(s.ctx, foreignURLs)
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, foreignURLs)
 		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{model.DeletionNotOwned}, statuses)
 
 		expectedURL := s.fixtures.URLS[1].ToCanonical()
-		res, err := s.storage.GetURL(s.ctx, 2)
+		res, err := s.storage.GetURL(s.ctx, s.fixtures.URLS[1].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(expectedURL, res)
+	})
+
+	s.Run("Remove removed and missing urls", func() {
+		statuses, err := s.storage.RemoveUsersURLs(s.ctx, []model.URL{
+			userURLs[0],
+			{Code: userURLs[0].Code, UserID: uuid.New()},
+			{Code: "m1N2o3P", UserID: userURLs[0].UserID},
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal([]model.DeletionStatus{
+			model.DeletionDeleted, model.DeletionNotFound, model.DeletionNotFound,
+		}, statuses)
+	})
+}
+
+func (s *TestSuite) TestURLs_RestoreURL() {
+	urlsToRestore := []model.URL{
+		{
+			Code:   "h7J8k9L",
+			UserID: uuid.New(),
+			URL:    "https://lengthy-url-11.com/",
+		},
+	}
+	since := time.Now().Add(-time.Hour)
+
+	s.Run("Restore deleted url by creator", func() {
+		_, err := s.storage.AddURLs(s.ctx, urlsToRestore, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+
+		_, err = s.storage.RemoveUsersURLs(s.ctx, urlsToRestore)
+		s.Require().NoError(err)
+
+		deleted, err := s.storage.GetUsersDeletedURLs(s.ctx, urlsToRestore[0].UserID, since)
+		s.Require().NoError(err)
+		s.Require().Len(deleted, 1)
+		s.Assert().Equal(urlsToRestore[0].Code, deleted[0].Code)
+		s.Assert().False(deleted[0].DeletedAt.IsZero())
+
+		res, err := s.storage.RestoreURL(s.ctx, urlsToRestore[0], since)
+		s.Require().NoError(err)
+		s.Assert().Equal(urlsToRestore[0].URL, res.URL)
+		s.Assert().True(res.DeletedAt.IsZero())
+
+		res, err = s.storage.GetURL(s.ctx, urlsToRestore[0].Code)
+		s.Require().NoError(err)
+		s.Assert().Equal(urlsToRestore[0].URL, res.URL)
+	})
+
+	s.Run("Restore url deleted before grace period", func() {
+		_, err := s.storage.RemoveUsersURLs(s.ctx, urlsToRestore)
+		s.Require().NoError(err)
+
+		res, err := s.storage.RestoreURL(s.ctx, urlsToRestore[0], time.Now().Add(time.Hour))
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Restore url by non-creator", func() {
+		res, err := s.storage.RestoreURL(s.ctx, model.URL{
+			Code:   urlsToRestore[0].Code,
+			UserID: uuid.New(),
+		}, since)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Restore url shortened again", func() {
+		_, err := s.storage.AddURLs(s.ctx, []model.URL{
+			{
+				Code:   "m2N3o4P",
+				UserID: uuid.New(),
+				URL:    urlsToRestore[0].URL,
+			},
+		}, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+
+		_, err = s.storage.RestoreURL(s.ctx, urlsToRestore[0], since)
+		s.Require().Error(err)
+		s.Assert().True(errors.Is(err, pkg.ErrAlreadyExists))
+	})
+}
+
+func (s *TestSuite) TestURLs_RemoveExpiredURLs() {
+	expiredURLs := []model.URL{
+		{
+			Code:      "p3Q4r5S",
+			UserID:    uuid.New(),
+			URL:       "https://lengthy-url-6.com/",
+			ExpiresAt: time.Now().Add(-time.Hour),
+		},
+	}
+
+	s.Run("Remove expired urls", func() {
+		_, err := s.storage.AddURLs(s.ctx, expiredURLs, model.DedupScopeGlobal)
+		s.Require().NoError(err)
+
+		cnt, err := s.storage.RemoveExpiredURLs(s.ctx, time.Now())
+		s.Require().NoError(err)
+		s.Assert().Equal(1, cnt)
+
+		res, err := s.storage.GetURL(s.ctx, expiredURLs[0].Code)
+		s.Require().NoError(err)
+		s.Assert().EqualValues(model.URL{}, res)
+	})
+
+	s.Run("Keep unexpired urls", func() {
+		expectedURL := s.fixtures.URLS[1].ToCanonical()
+
+		res, err := s.storage.GetURL(s.ctx, expectedURL.Code)
 		s.Require().NoError(err)
 		s.Assert().EqualValues(expectedURL, res)
 	})
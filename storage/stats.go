@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+// AggregateClicks builds statistics of given clicks for storages keeping clicks in memory.
+// Buckets are aligned to UTC, buckets without clicks are omitted, unique visitors are counted by IP.
+func AggregateClicks(clicks []model.Click, bucket time.Duration, topLimit int) model.URLStats {
+	stats := model.URLStats{TotalClicks: len(clicks)}
+
+	buckets := make(map[time.Time]int)
+	visitors := make(map[string]bool)
+	referrers := make(map[string]int)
+	userAgents := make(map[string]int)
+	for _, click := range clicks {
+		buckets[click.CreatedAt.UTC().Truncate(bucket)]++
+		visitors[click.IP] = true
+		if click.Referrer != "" {
+			referrers[click.Referrer]++
+		}
+		if click.UserAgent != "" {
+			userAgents[click.UserAgent]++
+		}
+	}
+
+	for start, cnt := range buckets {
+		stats.Buckets = append(stats.Buckets, model.StatsBucket{Start: start, Clicks: cnt})
+	}
+	sort.Slice(stats.Buckets, func(i, j int) bool {
+		return stats.Buckets[i].Start.Before(stats.Buckets[j].Start)
+	})
+
+	stats.UniqueVisitors = len(visitors)
+	stats.TopReferrers = topCounters(referrers, topLimit)
+	stats.TopUserAgents = topCounters(userAgents, topLimit)
+
+	return stats
+}
+
+// topCounters returns up to limit counters with the most clicks.
+func topCounters(counts map[string]int, limit int) []model.StatsCounter {
+	counters := make([]model.StatsCounter, 0, len(counts))
+	for value, clicks := range counts {
+		counters = append(counters, model.StatsCounter{Value: value, Clicks: clicks})
+	}
+
+	sort.Slice(counters, func(i, j int) bool {
+		if counters[i].Clicks != counters[j].Clicks {
+			return counters[i].Clicks > counters[j].Clicks
+		}
+		return counters[i].Value < counters[j].Value
+	})
+
+	if len(counters) > limit {
+		counters = counters[:limit]
+	}
+
+	return counters
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+func TestAggregateClicks(t *testing.T) {
+	from := time.Date(2022, 2, 15, 0, 0, 0, 0, time.UTC)
+	clicks := []model.Click{
+		{Referrer: "https://a.com/", UserAgent: "curl", IP: "10.0.0.1", CreatedAt: from.Add(time.Minute)},
+		{Referrer: "https://b.com/", UserAgent: "curl", IP: "10.0.0.1", CreatedAt: from.Add(2 * time.Minute)},
+		{Referrer: "https://b.com/", UserAgent: "Mozilla/5.0", IP: "10.0.0.2", CreatedAt: from.Add(2 * time.Hour)},
+		{UserAgent: "Mozilla/5.0", IP: "10.0.0.3", CreatedAt: from.Add(2*time.Hour + time.Minute)},
+	}
+
+	expected := model.URLStats{
+		TotalClicks:    4,
+		UniqueVisitors: 3,
+		Buckets: []model.StatsBucket{
+			{Start: from, Clicks: 2},
+			{Start: from.Add(2 * time.Hour), Clicks: 2},
+		},
+		TopReferrers: []model.StatsCounter{
+			{Value: "https://b.com/", Clicks: 2},
+		},
+		TopUserAgents: []model.StatsCounter{
+			{Value: "Mozilla/5.0", Clicks: 2},
+		},
+	}
+
+	assert.Equal(t, expected, AggregateClicks(clicks, time.Hour, 1))
+}
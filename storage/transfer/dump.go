@@ -0,0 +1,295 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	"github.com/vstdy/go-shortener/pkg/canonical"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
+	"github.com/vstdy/go-shortener/pkg/validator"
+	inter "github.com/vstdy/go-shortener/storage"
+)
+
+type (
+	// ImportConfig keeps import params.
+	// Without KeepIDs objects get ids following the last stored one,
+	// objects without short codes get codes of CodeGen then.
+	// Urls are converted to canonical form of URLCanonicalizer and validated against URLPolicy
+	// the same way as shortened ones, the url as given is kept for display.
+	ImportConfig struct {
+		Format           Format
+		BatchSize        int
+		KeepIDs          bool
+		DedupScope       model.DedupScope
+		CodeGen          shortcode.Generator
+		URLPolicy        validator.URLPolicy
+		URLCanonicalizer canonical.Canonicalizer
+	}
+
+	// ImportStats keeps numbers of imported and failed rows.
+	ImportStats struct {
+		Imported int
+		Failed   int
+	}
+
+	// importRow keeps url object to import along with its row number.
+	importRow struct {
+		row int
+		obj model.URL
+	}
+)
+
+// Export writes all url objects of the storage, including removed ones, in given format.
+// Returns number of exported objects.
+func Export(ctx context.Context, src inter.Storage, w io.Writer, format Format, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	enc, err := newURLEncoder(w, format)
+	if err != nil {
+		return 0, fmt.Errorf("transfer: %w", err)
+	}
+
+	count, afterID := 0, 0
+	for {
+		urls, err := src.ListURLs(ctx, afterID, batchSize)
+		if err != nil {
+			return count, fmt.Errorf("transfer: listing urls: %w", err)
+		}
+		if len(urls) == 0 {
+			break
+		}
+
+		for _, url := range urls {
+			if err = enc.Encode(url); err != nil {
+				return count, fmt.Errorf("transfer: encoding url %d: %w", url.ID, err)
+			}
+		}
+
+		afterID = urls[len(urls)-1].ID
+		count += len(urls)
+	}
+
+	if err = enc.Flush(); err != nil {
+		return count, fmt.Errorf("transfer: writing urls: %w", err)
+	}
+
+	return count, nil
+}
+
+// Import reads url objects in given format and adds them to the storage in batches.
+// Rows which are malformed, fail validation or conflict with stored objects are passed to report
+// and skipped. With KeepIDs objects with already stored ids are skipped silently,
+// so an interrupted import can be repeated.
+func Import(
+	ctx context.Context, dst inter.Storage, r io.Reader, config ImportConfig,
+	report func(rowErr RowError)) (ImportStats, error) {
+
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+
+	dec, err := newURLDecoder(r, config.Format)
+	if err != nil {
+		return ImportStats{}, fmt.Errorf("transfer: %w", err)
+	}
+
+	var nextID int
+	if !config.KeepIDs {
+		lastID, err := lastURLID(ctx, dst, config.BatchSize)
+		if err != nil {
+			return ImportStats{}, err
+		}
+		nextID = lastID + 1
+	}
+
+	var stats ImportStats
+	fail := func(rowErr RowError) {
+		stats.Failed++
+		report(rowErr)
+	}
+
+	now := time.Now()
+	ids := make(map[int]int)
+	batch := make([]importRow, 0, config.BatchSize)
+	for {
+		obj, row, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var rowErr RowError
+			if errors.As(err, &rowErr) {
+				fail(rowErr)
+				continue
+			}
+			return stats, fmt.Errorf("transfer: reading row %d: %w", row, err)
+		}
+
+		if err = prepareImportedURL(&obj, config, now); err != nil {
+			fail(RowError{Row: row, Err: err})
+			continue
+		}
+
+		if config.KeepIDs {
+			if prevRow, ok := ids[obj.ID]; ok {
+				fail(RowError{Row: row, Err: fmt.Errorf("id: duplicate of row %d", prevRow)})
+				continue
+			}
+			ids[obj.ID] = row
+		} else {
+			obj.ID = nextID
+			nextID++
+		}
+
+		batch = append(batch, importRow{row: row, obj: obj})
+		if len(batch) < config.BatchSize {
+			continue
+		}
+
+		if err = importBatch(ctx, dst, batch, &stats, fail); err != nil {
+			return stats, err
+		}
+		batch = batch[:0]
+	}
+
+	if err = importBatch(ctx, dst, batch, &stats, fail); err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+}
+
+// prepareImportedURL validates the imported url object and sets its defaults.
+// Objects without short codes get their legacy numeric ids as codes when ids are kept.
+func prepareImportedURL(obj *model.URL, config ImportConfig, now time.Time) error {
+	if config.KeepIDs && obj.ID < 1 {
+		return fmt.Errorf("id: must be positive")
+	}
+
+	if obj.UserID == uuid.Nil {
+		return fmt.Errorf("user_id: empty")
+	}
+
+	if obj.InputURL == "" {
+		obj.InputURL = obj.URL
+	}
+	if err := config.URLPolicy.ValidateLength(obj.InputURL); err != nil {
+		return fmt.Errorf("input_url: %v", err)
+	}
+
+	canonicalURL, err := config.URLCanonicalizer.Canonicalize(obj.URL)
+	if err != nil {
+		return fmt.Errorf("url: %v", err)
+	}
+	obj.URL = canonicalURL
+
+	if err := config.URLPolicy.Validate(obj.URL); err != nil {
+		return fmt.Errorf("url: %v", err)
+	}
+
+	if obj.RedirectCode != 0 {
+		if err := validator.ValidateRedirectCode(obj.RedirectCode); err != nil {
+			return fmt.Errorf("redirect_code: %v", err)
+		}
+	}
+
+	if obj.MaxClicks < 0 || obj.Clicks < 0 {
+		return fmt.Errorf("clicks: negative value")
+	}
+
+	switch {
+	case obj.Code != "":
+		if err := shortcode.Validate(obj.Code); err != nil {
+			return fmt.Errorf("code: %v", err)
+		}
+	case config.KeepIDs:
+		obj.Code = strconv.Itoa(obj.ID)
+	default:
+		code, err := config.CodeGen.Generate()
+		if err != nil {
+			return fmt.Errorf("code: %v", err)
+		}
+		obj.Code = code
+	}
+
+	if config.DedupScope == model.DedupScopeUser {
+		obj.DedupUserID = obj.UserID
+	}
+
+	if obj.CreatedAt.IsZero() {
+		obj.CreatedAt = now
+	}
+
+	return nil
+}
+
+// importBatch adds the batch of url objects to the storage.
+// A batch conflicting with stored objects is retried object by object to find conflicting rows.
+func importBatch(
+	ctx context.Context, dst inter.Storage, batch []importRow, stats *ImportStats,
+	fail func(rowErr RowError)) error {
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	objs := make([]model.URL, 0, len(batch))
+	for _, row := range batch {
+		objs = append(objs, row.obj)
+	}
+
+	err := dst.ImportURLs(ctx, objs)
+	if err == nil {
+		stats.Imported += len(batch)
+		return nil
+	}
+	if !isConflict(err) {
+		return fmt.Errorf("transfer: importing rows %d-%d: %w", batch[0].row, batch[len(batch)-1].row, err)
+	}
+
+	for _, row := range batch {
+		err = dst.ImportURLs(ctx, []model.URL{row.obj})
+		if err == nil {
+			stats.Imported++
+			continue
+		}
+		if !isConflict(err) {
+			return fmt.Errorf("transfer: importing row %d: %w", row.row, err)
+		}
+
+		fail(RowError{Row: row.row, Err: err})
+	}
+
+	return nil
+}
+
+// isConflict checks the error is caused by objects conflicting with stored ones.
+func isConflict(err error) bool {
+	return errors.Is(err, pkg.ErrAlreadyExists) || errors.Is(err, pkg.ErrCodeTaken)
+}
+
+// lastURLID returns id of the last stored url object.
+func lastURLID(ctx context.Context, st inter.Storage, batchSize int) (int, error) {
+	lastID := 0
+	for {
+		urls, err := st.ListURLs(ctx, lastID, batchSize)
+		if err != nil {
+			return 0, fmt.Errorf("transfer: listing urls: %w", err)
+		}
+		if len(urls) == 0 {
+			return lastID, nil
+		}
+
+		lastID = urls[len(urls)-1].ID
+	}
+}
@@ -0,0 +1,214 @@
+package transfer
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/pkg"
+	"github.com/vstdy/go-shortener/pkg/canonical"
+	"github.com/vstdy/go-shortener/pkg/shortcode"
+	"github.com/vstdy/go-shortener/pkg/validator"
+	"github.com/vstdy/go-shortener/storage/memory"
+)
+
+const testCSV = `1,45707442-5be5-4ad3-ad71-d379f0968d2e,https://lengthy-url-10.com/,2022-02-15 10:59:09.459102 +00:00,2022-02-15 11:15:17.771371 +00:00,
+2,45707442-5be5-4ad3-ad71-d379f0968d2e,https://lengthy-url-11.com/,2022-02-15 11:15:45.711596 +00:00,2022-02-15 11:15:45.711596 +00:00,2022-02-16 08:00:00 +00:00
+3,69cdfdef-281a-4aee-ba3c-fe1d20183249,https://lengthy-url-12.com/,2022-02-15 11:17:32.527621 +00:00,2022-02-15 11:17:32.527621 +00:00,,q1W2e3R
+4,69cdfdef-281a-4aee-ba3c-fe1d20183249,lengthy-url-13,2022-02-15 11:16:39.079655 +00:00,2022-02-15 11:16:39.079655 +00:00,
+5,not-a-uuid,https://lengthy-url-14.com/,2022-02-15 11:16:39.079655 +00:00,2022-02-15 11:16:39.079655 +00:00,
+1,69cdfdef-281a-4aee-ba3c-fe1d20183249,https://lengthy-url-15.com/,,,
+`
+
+// collectRowErrors returns report func saving reported row errors.
+func collectRowErrors(rowErrs *[]RowError) func(rowErr RowError) {
+	return func(rowErr RowError) {
+		*rowErrs = append(*rowErrs, rowErr)
+	}
+}
+
+func (s *TestSuite) TestImport_CSV() {
+	var rowErrs []RowError
+	config := ImportConfig{Format: FormatCSV, KeepIDs: true, DedupScope: model.DedupScopeGlobal}
+	stats, err := Import(s.ctx, s.dst, strings.NewReader(testCSV), config, collectRowErrors(&rowErrs))
+	s.Require().NoError(err)
+	s.Assert().Equal(ImportStats{Imported: 3, Failed: 3}, stats)
+
+	s.Require().Len(rowErrs, 3)
+	s.Assert().Equal(4, rowErrs[0].Row)
+	s.Assert().Contains(rowErrs[0].Error(), "url:")
+	s.Assert().Equal(5, rowErrs[1].Row)
+	s.Assert().Contains(rowErrs[1].Error(), "user_id:")
+	s.Assert().Equal(6, rowErrs[2].Row)
+	s.Assert().Contains(rowErrs[2].Error(), "id: duplicate of row 1")
+
+	urls, err := s.dst.ListURLs(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	s.Require().Len(urls, 3)
+
+	s.Assert().Equal(1, urls[0].ID)
+	s.Assert().Equal("1", urls[0].Code)
+	s.Assert().Equal(uuid.MustParse("45707442-5be5-4ad3-ad71-d379f0968d2e"), urls[0].UserID)
+	s.Assert().Equal(time.Date(2022, 2, 15, 10, 59, 9, 459102000, time.UTC), urls[0].CreatedAt.UTC())
+	s.Assert().True(urls[0].DeletedAt.IsZero())
+
+	s.Assert().Equal(time.Date(2022, 2, 16, 8, 0, 0, 0, time.UTC), urls[1].DeletedAt.UTC())
+	s.Assert().Equal("q1W2e3R", urls[2].Code)
+}
+
+func (s *TestSuite) TestImport_URLPolicy() {
+	data := strings.Join([]string{
+		`{"id":1,"user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"https://lengthy-url-30.com/"}`,
+		`{"id":2,"user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"javascript:alert(1)"}`,
+		`{"id":3,"user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"http://192.168.1.1/admin"}`,
+		`{"id":4,"user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"http://127.0.0.1:8080/a1B2c3D"}`,
+		`{"id":5,"user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"https://lengthy-url-31.com/?utm_source=` +
+			strings.Repeat("a", 64) + `"}`,
+	}, "\n")
+
+	var rowErrs []RowError
+	config := ImportConfig{
+		Format:     FormatJSONL,
+		KeepIDs:    true,
+		DedupScope: model.DedupScopeGlobal,
+		URLPolicy: validator.URLPolicy{
+			Schemes:            []string{"http", "https"},
+			MaxLength:          64,
+			RejectPrivateHosts: true,
+			BaseURL:            "http://127.0.0.1:8080",
+		},
+		URLCanonicalizer: canonical.Canonicalizer{StripParams: []string{"utm_*"}},
+	}
+	stats, err := Import(s.ctx, s.dst, strings.NewReader(data), config, collectRowErrors(&rowErrs))
+	s.Require().NoError(err)
+	s.Assert().Equal(ImportStats{Imported: 1, Failed: 4}, stats)
+
+	s.Require().Len(rowErrs, 4)
+	for idx, rowErr := range rowErrs {
+		s.Assert().Equal(idx+2, rowErr.Row)
+		s.Assert().Contains(rowErr.Error(), "url:")
+	}
+}
+
+func (s *TestSuite) TestImport_Canonical() {
+	s.Require().NoError(s.dst.ImportURLs(s.ctx, []model.URL{
+		{ID: 1, Code: "a1B2c3D", UserID: uuid.New(), URL: "https://lengthy-url-40.com/a"},
+	}))
+
+	data := strings.Join([]string{
+		`{"id":2,"user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"HTTPS://Lengthy-URL-41.com:443/a?utm_source=x&id=1"}`,
+		`{"id":3,"user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"https://lengthy-url-40.com:443/a?fbclid=y"}`,
+	}, "\n")
+
+	var rowErrs []RowError
+	config := ImportConfig{
+		Format:           FormatJSONL,
+		KeepIDs:          true,
+		DedupScope:       model.DedupScopeGlobal,
+		URLCanonicalizer: canonical.Canonicalizer{StripParams: []string{"utm_*", "fbclid"}},
+	}
+	stats, err := Import(s.ctx, s.dst, strings.NewReader(data), config, collectRowErrors(&rowErrs))
+	s.Require().NoError(err)
+	s.Assert().Equal(ImportStats{Imported: 1, Failed: 1}, stats)
+
+	s.Require().Len(rowErrs, 1)
+	s.Assert().Equal(2, rowErrs[0].Row)
+	s.Assert().True(errors.Is(rowErrs[0], pkg.ErrAlreadyExists))
+
+	urls, err := s.dst.ListURLs(s.ctx, 1, 100)
+	s.Require().NoError(err)
+	s.Require().Len(urls, 1)
+	s.Assert().Equal("https://lengthy-url-41.com/a?id=1", urls[0].URL)
+	s.Assert().Equal("HTTPS://Lengthy-URL-41.com:443/a?utm_source=x&id=1", urls[0].InputURL)
+}
+
+func (s *TestSuite) TestImport_ReassignIDs() {
+	codeGen, err := shortcode.NewGenerator(shortcode.DefaultAlphabet, 7)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.dst.ImportURLs(s.ctx, s.urls[2:]))
+
+	var rowErrs []RowError
+	config := ImportConfig{Format: FormatCSV, DedupScope: model.DedupScopeUser, CodeGen: codeGen}
+	stats, err := Import(s.ctx, s.dst, strings.NewReader(testCSV), config, collectRowErrors(&rowErrs))
+	s.Require().NoError(err)
+	s.Assert().Equal(ImportStats{Imported: 4, Failed: 2}, stats)
+
+	urls, err := s.dst.ListURLs(s.ctx, s.urls[2].ID, 100)
+	s.Require().NoError(err)
+	s.Require().Len(urls, 4)
+	for idx, url := range urls {
+		s.Assert().Equal(s.urls[2].ID+1+idx, url.ID)
+		s.Assert().False(shortcode.IsLegacy(url.Code))
+		s.Assert().Equal(url.UserID, url.DedupUserID)
+	}
+	s.Assert().Equal("https://lengthy-url-15.com/", urls[3].URL)
+}
+
+func (s *TestSuite) TestImport_Conflicts() {
+	s.Require().NoError(s.dst.ImportURLs(s.ctx, s.urls[:1]))
+
+	data := strings.Join([]string{
+		`{"id":10,"code":"r4T5y6U","user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"https://lengthy-url-20.com/"}`,
+		`{"id":11,"code":"` + s.urls[0].Code + `","user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"https://lengthy-url-21.com/"}`,
+		``,
+		`{"id":12,"user_id":"` + s.urls[0].UserID.String() + `","url":"` + s.urls[0].URL + `"}`,
+		`{"id":13,"user_id":"45707442-5be5-4ad3-ad71-d379f0968d2e","url":"https://lengthy-url-20.com/"}`,
+		`{"id":14,"user_id":`,
+	}, "\n")
+
+	var rowErrs []RowError
+	config := ImportConfig{Format: FormatJSONL, KeepIDs: true, DedupScope: model.DedupScopeUser}
+	stats, err := Import(s.ctx, s.dst, strings.NewReader(data), config, collectRowErrors(&rowErrs))
+	s.Require().NoError(err)
+	s.Assert().Equal(ImportStats{Imported: 1, Failed: 4}, stats)
+
+	s.Require().Len(rowErrs, 4)
+	s.Assert().Equal(6, rowErrs[0].Row)
+	s.Assert().Equal(2, rowErrs[1].Row)
+	s.Assert().True(errors.Is(rowErrs[1], pkg.ErrCodeTaken))
+	s.Assert().Equal(4, rowErrs[2].Row)
+	s.Assert().True(errors.Is(rowErrs[2], pkg.ErrAlreadyExists))
+	s.Assert().Equal(5, rowErrs[3].Row)
+	s.Assert().True(errors.Is(rowErrs[3], pkg.ErrAlreadyExists))
+}
+
+func (s *TestSuite) TestExport() {
+	srcURLs, err := s.src.ListURLs(s.ctx, 0, 100)
+	s.Require().NoError(err)
+
+	for _, format := range []Format{FormatJSONL, FormatCSV} {
+		s.Run(string(format), func() {
+			var buf bytes.Buffer
+			count, err := Export(s.ctx, s.src, &buf, format, 2)
+			s.Require().NoError(err)
+			s.Assert().Equal(3, count)
+
+			dst, err := memory.NewStorage()
+			s.Require().NoError(err)
+
+			var rowErrs []RowError
+			config := ImportConfig{Format: format, KeepIDs: true, DedupScope: model.DedupScopeUser}
+			stats, err := Import(s.ctx, dst, &buf, config, collectRowErrors(&rowErrs))
+			s.Require().NoError(err)
+			s.Assert().Empty(rowErrs)
+			s.Assert().Equal(ImportStats{Imported: 3}, stats)
+
+			dstURLs, err := dst.ListURLs(s.ctx, 0, 100)
+			s.Require().NoError(err)
+			s.Require().Len(dstURLs, len(srcURLs))
+			for idx := range srcURLs {
+				s.Assert().Equal(srcURLs[idx].ID, dstURLs[idx].ID)
+				s.Assert().Equal(srcURLs[idx].Code, dstURLs[idx].Code)
+				s.Assert().Equal(srcURLs[idx].UserID, dstURLs[idx].UserID)
+				s.Assert().Equal(srcURLs[idx].URL, dstURLs[idx].URL)
+				s.Assert().WithinDuration(srcURLs[idx].CreatedAt, dstURLs[idx].CreatedAt, time.Microsecond)
+				s.Assert().WithinDuration(srcURLs[idx].DeletedAt, dstURLs[idx].DeletedAt, time.Microsecond)
+			}
+		})
+	}
+}
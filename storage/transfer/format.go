@@ -0,0 +1,297 @@
+package transfer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vstdy/go-shortener/model"
+)
+
+// Format defines format of exported url objects.
+type Format string
+
+const (
+	// FormatJSONL keeps an url object per line as JSON with all its fields.
+	FormatJSONL Format = "jsonl"
+	// FormatCSV keeps an url object per line as id, user_id, url, created_at, updated_at, deleted_at
+	// and an optional short code column.
+	FormatCSV Format = "csv"
+)
+
+const csvTimeLayout = "2006-01-02 15:04:05.999999 -07:00"
+
+type (
+	// RowError describes a row of the imported data which can not be imported.
+	RowError struct {
+		Row int
+		Err error
+	}
+
+	// urlEncoder writes url objects in one of supported formats.
+	urlEncoder interface {
+		Encode(obj model.URL) error
+		Flush() error
+	}
+
+	// urlDecoder reads url objects in one of supported formats along with their row numbers.
+	// RowError is returned for a malformed row, io.EOF is returned at the end of data.
+	urlDecoder interface {
+		Decode() (model.URL, int, error)
+	}
+
+	// urlRecord keeps url object data in JSON lines format.
+	urlRecord struct {
+		ID           int       `json:"id"`
+		Code         string    `json:"code,omitempty"`
+		UserID       uuid.UUID `json:"user_id"`
+		URL          string    `json:"url"`
+		InputURL     string    `json:"input_url,omitempty"`
+		ExpiresAt    time.Time `json:"expires_at"`
+		MaxClicks    int       `json:"max_clicks"`
+		Clicks       int       `json:"clicks"`
+		RedirectCode int       `json:"redirect_code"`
+		CreatedAt    time.Time `json:"created_at"`
+		DeletedAt    time.Time `json:"deleted_at"`
+	}
+)
+
+// Error implements error interface.
+func (e RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+// Unwrap returns the row error reason.
+func (e RowError) Unwrap() error {
+	return e.Err
+}
+
+// Validate checks the format is supported.
+func (f Format) Validate() error {
+	switch f {
+	case FormatJSONL, FormatCSV:
+		return nil
+	default:
+		return fmt.Errorf("unsupported format %q", f)
+	}
+}
+
+// newURLEncoder creates url objects encoder of the format.
+func newURLEncoder(w io.Writer, format Format) (urlEncoder, error) {
+	switch format {
+	case FormatJSONL:
+		return newJSONLEncoder(w), nil
+	case FormatCSV:
+		return &csvEncoder{w: csv.NewWriter(w)}, nil
+	default:
+		return nil, format.Validate()
+	}
+}
+
+// newURLDecoder creates url objects decoder of the format.
+func newURLDecoder(r io.Reader, format Format) (urlDecoder, error) {
+	switch format {
+	case FormatJSONL:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, 1<<20)
+		return &jsonlDecoder{scanner: scanner}, nil
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		return &csvDecoder{r: reader}, nil
+	default:
+		return nil, format.Validate()
+	}
+}
+
+type jsonlEncoder struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONLEncoder(w io.Writer) *jsonlEncoder {
+	bw := bufio.NewWriter(w)
+
+	return &jsonlEncoder{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// Encode writes the url object as a JSON line.
+func (e *jsonlEncoder) Encode(obj model.URL) error {
+	return e.enc.Encode(urlRecord{
+		ID:           obj.ID,
+		Code:         obj.Code,
+		UserID:       obj.UserID,
+		URL:          obj.URL,
+		InputURL:     obj.InputURL,
+		ExpiresAt:    obj.ExpiresAt,
+		MaxClicks:    obj.MaxClicks,
+		Clicks:       obj.Clicks,
+		RedirectCode: obj.RedirectCode,
+		CreatedAt:    obj.CreatedAt,
+		DeletedAt:    obj.DeletedAt,
+	})
+}
+
+// Flush writes buffered lines.
+func (e *jsonlEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+type jsonlDecoder struct {
+	scanner *bufio.Scanner
+	row     int
+}
+
+// Decode reads the next not empty JSON line.
+func (d *jsonlDecoder) Decode() (model.URL, int, error) {
+	for d.scanner.Scan() {
+		d.row++
+		if len(d.scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record urlRecord
+		if err := json.Unmarshal(d.scanner.Bytes(), &record); err != nil {
+			return model.URL{}, d.row, RowError{Row: d.row, Err: err}
+		}
+
+		return model.URL{
+			ID:           record.ID,
+			Code:         record.Code,
+			UserID:       record.UserID,
+			URL:          record.URL,
+			InputURL:     record.InputURL,
+			ExpiresAt:    record.ExpiresAt,
+			MaxClicks:    record.MaxClicks,
+			Clicks:       record.Clicks,
+			RedirectCode: record.RedirectCode,
+			CreatedAt:    record.CreatedAt,
+			DeletedAt:    record.DeletedAt,
+		}, d.row, nil
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return model.URL{}, d.row, err
+	}
+
+	return model.URL{}, d.row, io.EOF
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+// Encode writes the url object as a CSV record.
+// Creation time is written as update time, as the last update time is not kept.
+func (e *csvEncoder) Encode(obj model.URL) error {
+	createdAt := formatCSVTime(obj.CreatedAt)
+
+	return e.w.Write([]string{
+		strconv.Itoa(obj.ID),
+		obj.UserID.String(),
+		obj.URL,
+		createdAt,
+		createdAt,
+		formatCSVTime(obj.DeletedAt),
+		obj.Code,
+	})
+}
+
+// Flush writes buffered records.
+func (e *csvEncoder) Flush() error {
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+type csvDecoder struct {
+	r   *csv.Reader
+	row int
+}
+
+// Decode reads the next CSV record.
+// Update time is not kept and is checked for format only.
+func (d *csvDecoder) Decode() (model.URL, int, error) {
+	record, err := d.r.Read()
+	if err == io.EOF {
+		return model.URL{}, d.row, io.EOF
+	}
+	d.row++
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return model.URL{}, d.row, RowError{Row: d.row, Err: parseErr.Err}
+		}
+		return model.URL{}, d.row, err
+	}
+
+	obj, err := parseCSVRecord(record)
+	if err != nil {
+		return model.URL{}, d.row, RowError{Row: d.row, Err: err}
+	}
+
+	return obj, d.row, nil
+}
+
+// parseCSVRecord parses url object of the CSV record.
+func parseCSVRecord(record []string) (model.URL, error) {
+	if len(record) != 6 && len(record) != 7 {
+		return model.URL{}, fmt.Errorf("wrong number of fields: %d", len(record))
+	}
+
+	var obj model.URL
+	var err error
+
+	if record[0] != "" {
+		if obj.ID, err = strconv.Atoi(record[0]); err != nil {
+			return model.URL{}, fmt.Errorf("id: %v", err)
+		}
+	}
+
+	if obj.UserID, err = uuid.Parse(record[1]); err != nil {
+		return model.URL{}, fmt.Errorf("user_id: %v", err)
+	}
+
+	obj.URL = record[2]
+
+	if obj.CreatedAt, err = parseCSVTime(record[3]); err != nil {
+		return model.URL{}, fmt.Errorf("created_at: %v", err)
+	}
+	if _, err = parseCSVTime(record[4]); err != nil {
+		return model.URL{}, fmt.Errorf("updated_at: %v", err)
+	}
+	if obj.DeletedAt, err = parseCSVTime(record[5]); err != nil {
+		return model.URL{}, fmt.Errorf("deleted_at: %v", err)
+	}
+
+	if len(record) == 7 {
+		obj.Code = record[6]
+	}
+
+	return obj, nil
+}
+
+// formatCSVTime formats time in UTC, zero time is formatted as an empty string.
+func formatCSVTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(csvTimeLayout)
+}
+
+// parseCSVTime parses time, an empty string is parsed as zero time.
+func parseCSVTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(csvTimeLayout, value)
+}
@@ -0,0 +1,141 @@
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	inter "github.com/vstdy/go-shortener/storage"
+)
+
+const defaultBatchSize = 1000
+
+// ErrPendingDeletions is returned when the source storage has deletions not processed yet.
+var ErrPendingDeletions = errors.New("source storage has pending deletions")
+
+type (
+	// Config keeps data transfer params.
+	// With DryRun objects are read from the source only.
+	Config struct {
+		BatchSize int
+		DryRun    bool
+	}
+
+	// Position keeps ids of the last transferred url and click objects.
+	Position struct {
+		URLID   int `json:"url_id"`
+		ClickID int `json:"click_id"`
+	}
+
+	// Stats keeps numbers of transferred url and click objects.
+	Stats struct {
+		URLs   int
+		Clicks int
+	}
+)
+
+// Run streams url objects and then click objects from src storage to dst storage
+// in batches keeping their ids, starting after given position.
+// Checkpoint is called with the position reached after every transferred batch.
+// Deletion objects are not transferred, so Run refuses to start while the source storage
+// has pending deletions, dead deletions and deletion job outcomes are left behind.
+func Run(
+	ctx context.Context, src, dst inter.Storage, config Config, pos Position,
+	checkpoint func(pos Position) error) (Stats, error) {
+
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+
+	var stats Stats
+
+	pending, err := src.HasPendingDeletions(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("transfer: checking pending deletions: %w", err)
+	}
+	if pending {
+		return stats, fmt.Errorf("transfer: %w", ErrPendingDeletions)
+	}
+
+	for {
+		urls, err := src.ListURLs(ctx, pos.URLID, config.BatchSize)
+		if err != nil {
+			return stats, fmt.Errorf("transfer: listing urls: %w", err)
+		}
+		if len(urls) == 0 {
+			break
+		}
+
+		if !config.DryRun {
+			if err = dst.ImportURLs(ctx, urls); err != nil {
+				return stats, fmt.Errorf("transfer: importing urls after id %d: %w", pos.URLID, err)
+			}
+		}
+
+		pos.URLID = urls[len(urls)-1].ID
+		stats.URLs += len(urls)
+		if err = checkpoint(pos); err != nil {
+			return stats, fmt.Errorf("transfer: checkpoint: %w", err)
+		}
+	}
+
+	for {
+		clicks, err := src.ListClicks(ctx, pos.ClickID, config.BatchSize)
+		if err != nil {
+			return stats, fmt.Errorf("transfer: listing clicks: %w", err)
+		}
+		if len(clicks) == 0 {
+			break
+		}
+
+		if !config.DryRun {
+			if err = dst.ImportClicks(ctx, clicks); err != nil {
+				return stats, fmt.Errorf("transfer: importing clicks after id %d: %w", pos.ClickID, err)
+			}
+		}
+
+		pos.ClickID = clicks[len(clicks)-1].ID
+		stats.Clicks += len(clicks)
+		if err = checkpoint(pos); err != nil {
+			return stats, fmt.Errorf("transfer: checkpoint: %w", err)
+		}
+	}
+
+	return stats, nil
+}
+
+// LoadPosition reads transfer position from the file,
+// zero position is returned if the file does not exist.
+func LoadPosition(path string) (Position, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Position{}, nil
+		}
+		return Position{}, err
+	}
+
+	var pos Position
+	if err = json.Unmarshal(data, &pos); err != nil {
+		return Position{}, fmt.Errorf("decoding position: %w", err)
+	}
+
+	return pos, nil
+}
+
+// SavePosition writes transfer position to the file replacing it atomically.
+func SavePosition(path string, pos Position) error {
+	data, err := json.Marshal(pos)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err = os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,161 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/vstdy/go-shortener/model"
+	"github.com/vstdy/go-shortener/storage/memory"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	src  *memory.Storage
+	dst  *memory.Storage
+	urls []model.URL
+
+	ctx context.Context
+}
+
+func (s *TestSuite) SetupTest() {
+	s.ctx = context.TODO()
+
+	src, err := memory.NewStorage()
+	s.Require().NoError(err)
+	dst, err := memory.NewStorage()
+	s.Require().NoError(err)
+	s.src, s.dst = src, dst
+
+	userID := uuid.New()
+	s.urls, err = s.src.AddURLs(s.ctx, []model.URL{
+		{Code: "a1B2c3D", UserID: userID, URL: "https://lengthy-url-1.com/"},
+		{Code: "e4F5g6H", UserID: userID, URL: "https://lengthy-url-2.com/"},
+		{Code: "i7J8k9L", UserID: uuid.New(), URL: "https://lengthy-url-3.com/"},
+	}, model.DedupScopeUser)
+	s.Require().NoError(err)
+
+	_, err = s.src.RemoveUsersURLs(s.ctx, s.urls[1:2])
+	s.Require().NoError(err)
+	s.Require().NoError(s.src.AddClicks(s.ctx, []model.Click{
+		{URLID: s.urls[0].ID, Referrer: "https://referrer.com/", CreatedAt: time.Now()},
+		{URLID: s.urls[2].ID, UserAgent: "curl/7.79.1", CreatedAt: time.Now()},
+	}))
+}
+
+// collectPositions returns checkpoint func saving reached positions.
+func collectPositions(positions *[]Position) func(pos Position) error {
+	return func(pos Position) error {
+		*positions = append(*positions, pos)
+		return nil
+	}
+}
+
+func (s *TestSuite) TestRun() {
+	var positions []Position
+	stats, err := Run(s.ctx, s.src, s.dst, Config{BatchSize: 2}, Position{}, collectPositions(&positions))
+	s.Require().NoError(err)
+	s.Assert().Equal(Stats{URLs: 3, Clicks: 2}, stats)
+	s.Assert().Equal([]Position{{URLID: 2}, {URLID: 3}, {URLID: 3, ClickID: 2}}, positions)
+
+	srcURLs, err := s.src.ListURLs(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	dstURLs, err := s.dst.ListURLs(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	s.Assert().Equal(srcURLs, dstURLs)
+	s.Assert().False(dstURLs[1].DeletedAt.IsZero())
+
+	srcClicks, err := s.src.ListClicks(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	dstClicks, err := s.dst.ListClicks(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	s.Assert().Equal(srcClicks, dstClicks)
+
+	s.Run("New objects get ids after transferred ones", func() {
+		res, err := s.dst.AddURLs(s.ctx, []model.URL{
+			{Code: "m3N4o5P", UserID: uuid.New(), URL: "https://lengthy-url-4.com/"},
+		}, model.DedupScopeUser)
+		s.Require().NoError(err)
+		s.Assert().Equal(4, res[0].ID)
+	})
+}
+
+func (s *TestSuite) TestRun_DryRun() {
+	var positions []Position
+	stats, err := Run(s.ctx, s.src, s.dst, Config{DryRun: true}, Position{}, collectPositions(&positions))
+	s.Require().NoError(err)
+	s.Assert().Equal(Stats{URLs: 3, Clicks: 2}, stats)
+
+	dstURLs, err := s.dst.ListURLs(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	s.Assert().Empty(dstURLs)
+}
+
+func (s *TestSuite) TestRun_Resume() {
+	s.Require().NoError(s.dst.ImportURLs(s.ctx, s.urls[:2]))
+
+	var positions []Position
+	stats, err := Run(s.ctx, s.src, s.dst, Config{}, Position{URLID: s.urls[1].ID}, collectPositions(&positions))
+	s.Require().NoError(err)
+	s.Assert().Equal(Stats{URLs: 1, Clicks: 2}, stats)
+
+	s.Run("Repeated run skips transferred objects", func() {
+		_, err := Run(s.ctx, s.src, s.dst, Config{}, Position{}, collectPositions(&positions))
+		s.Require().NoError(err)
+
+		dstURLs, err := s.dst.ListURLs(s.ctx, 0, 100)
+		s.Require().NoError(err)
+		s.Assert().Len(dstURLs, 3)
+
+		dstClicks, err := s.dst.ListClicks(s.ctx, 0, 100)
+		s.Require().NoError(err)
+		s.Assert().Len(dstClicks, 2)
+	})
+}
+
+func (s *TestSuite) TestRun_PendingDeletions() {
+	_, err := s.src.AddDeletions(s.ctx, []model.Deletion{{
+		JobID:         uuid.New(),
+		UserID:        s.urls[0].UserID,
+		Code:          s.urls[0].Code,
+		Status:        model.DeletionPending,
+		NextAttemptAt: time.Now().Add(time.Hour),
+		CreatedAt:     time.Now(),
+	}})
+	s.Require().NoError(err)
+
+	var positions []Position
+	stats, err := Run(s.ctx, s.src, s.dst, Config{}, Position{}, collectPositions(&positions))
+	s.Require().Error(err)
+	s.Assert().True(errors.Is(err, ErrPendingDeletions))
+	s.Assert().Equal(Stats{}, stats)
+	s.Assert().Empty(positions)
+
+	urls, err := s.dst.ListURLs(s.ctx, 0, 100)
+	s.Require().NoError(err)
+	s.Assert().Empty(urls)
+}
+
+func (s *TestSuite) TestPosition() {
+	path := filepath.Join(s.T().TempDir(), "position.json")
+
+	pos, err := LoadPosition(path)
+	s.Require().NoError(err)
+	s.Assert().Equal(Position{}, pos)
+
+	s.Require().NoError(SavePosition(path, Position{URLID: 10, ClickID: 20}))
+
+	pos, err = LoadPosition(path)
+	s.Require().NoError(err)
+	s.Assert().Equal(Position{URLID: 10, ClickID: 20}, pos)
+}
+
+func TestSuite_Transfer(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}